@@ -6,9 +6,11 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/induzo/gocom/http/health"
 	"github.com/induzo/gocom/shutdown"
+	"github.com/jackc/pgx/v5"
 
 	"realworld/internal/cmd"
 	"realworld/internal/domain"
@@ -77,8 +79,66 @@ type Config struct {
 
 	DatabaseURL string `koanf:"database_url"`
 
+	Database struct {
+		// TxIsoLevel is the isolation level of the transactions, such as read committed or serializable
+		TxIsoLevel string `koanf:"tx_iso_level"`
+		// TxMaxRetries is how many times a transaction failing to serialize is rerun
+		TxMaxRetries int `koanf:"tx_max_retries"`
+	} `koanf:"database"`
+
+	// LegacyErrorFormat writes errors as the RealWorld errors.body instead of problem+json
+	LegacyErrorFormat bool `koanf:"legacy_error_format"`
+
+	Search struct {
+		// Language is the postgres text search configuration of the articles
+		Language string `koanf:"language"`
+	} `koanf:"search"`
+
+	Articles struct {
+		// PublishInterval is how often the scheduled articles due are published
+		PublishInterval time.Duration `koanf:"publish_interval"`
+		// BodyHTMLCacheTTL is how long the rendered body of a version of an article is cached
+		BodyHTMLCacheTTL time.Duration `koanf:"body_html_cache_ttl"`
+		// TrashRetention is how long the deleted articles are kept in the trash of their author
+		TrashRetention time.Duration `koanf:"trash_retention"`
+		// PurgeInterval is how often the articles trashed for longer than the retention are deleted
+		PurgeInterval time.Duration `koanf:"purge_interval"`
+	} `koanf:"articles"`
+
+	Comments struct {
+		// MaxDepth is how deep the replies to the comments can be nested, 0 disables the replies
+		MaxDepth int `koanf:"max_depth"`
+		// EditWindow is how long after they are posted the comments can be edited, 0 disables the edits
+		EditWindow time.Duration `koanf:"edit_window"`
+	} `koanf:"comments"`
+
+	Tags struct {
+		// GCInterval is how often the tags no article uses are deleted
+		GCInterval time.Duration `koanf:"gc_interval"`
+	} `koanf:"tags"`
+
 	Security struct {
-		JWTSecret string `koanf:"jwt_secret"`
+		JWTSecret          string        `koanf:"jwt_secret"`
+		CursorSecret       string        `koanf:"cursor_secret"`
+		AccessTokenTTL     time.Duration `koanf:"access_token_ttl"`
+		RefreshTokenTTL    time.Duration `koanf:"refresh_token_ttl"`
+		RevocationCacheTTL time.Duration `koanf:"revocation_cache_ttl"`
+
+		// JWTKeys replaces JWTSecret when set
+		JWTKeys []struct {
+			ID             string `koanf:"kid"`
+			Algorithm      string `koanf:"algorithm"`
+			PrivateKeyPath string `koanf:"private_key_path"`
+			Status         string `koanf:"status"`
+		} `koanf:"jwt_keys"`
+
+		Password struct {
+			MemoryKiB   uint32 `koanf:"memory_kib"`
+			Iterations  uint32 `koanf:"iterations"`
+			Parallelism uint8  `koanf:"parallelism"`
+			SaltLength  uint32 `koanf:"salt_length"`
+			KeyLength   uint32 `koanf:"key_length"`
+		} `koanf:"password"`
 	} `koanf:"security"`
 }
 
@@ -86,6 +146,34 @@ func (cfg *Config) GetBasicConfig() cmd.BasicConfig {
 	return cfg.BasicConfig
 }
 
+func newJWTKeys(cfg *Config) (*httpapi.JWTKeys, error) {
+	if len(cfg.Security.JWTKeys) == 0 {
+		keys, err := httpapi.NewHMACJWTKeys(cfg.Security.JWTSecret)
+		if err != nil {
+			return nil, fmt.Errorf("hmac: %w", err)
+		}
+
+		return keys, nil
+	}
+
+	keyConfigs := make([]httpapi.JWTKeyConfig, 0, len(cfg.Security.JWTKeys))
+	for _, key := range cfg.Security.JWTKeys {
+		keyConfigs = append(keyConfigs, httpapi.JWTKeyConfig{
+			ID:             key.ID,
+			Algorithm:      key.Algorithm,
+			PrivateKeyPath: key.PrivateKeyPath,
+			Status:         httpapi.JWTKeyStatus(key.Status),
+		})
+	}
+
+	keys, err := httpapi.NewJWTKeys(keyConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("asymmetric: %w", err)
+	}
+
+	return keys, nil
+}
+
 type apiServer struct {
 	server          *cmd.Server[*Config]
 	cfg             *Config
@@ -105,8 +193,26 @@ func newAPIServer(
 		return nil, fmt.Errorf("failed to create api server: %w", err)
 	}
 
+	// checked before connecting, a bad configuration fails fast
+	passwordParams := domain.PasswordParams{
+		Memory:      cfg.Security.Password.MemoryKiB,
+		Iterations:  cfg.Security.Password.Iterations,
+		Parallelism: cfg.Security.Password.Parallelism,
+		SaltLength:  cfg.Security.Password.SaltLength,
+		KeyLength:   cfg.Security.Password.KeyLength,
+	}
+	if errP := passwordParams.Validate(); errP != nil {
+		return nil, fmt.Errorf("failed to configure password hashing: %w", errP)
+	}
+
 	// new db repository
-	rpstry, err := db.NewRepository(ctx, cfg.DatabaseURL)
+	rpstry, err := db.NewRepository(
+		ctx,
+		cfg.DatabaseURL,
+		db.WithSearchLanguage(cfg.Search.Language),
+		db.WithTxIsoLevel(pgx.TxIsoLevel(cfg.Database.TxIsoLevel)),
+		db.WithTxMaxRetries(cfg.Database.TxMaxRetries),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create repo: %w", err)
 	}
@@ -117,7 +223,24 @@ func newAPIServer(
 		shutdownHandler.Add("pg repository", shut)
 	}
 
-	svc := domain.NewAPISvc(rpstry)
+	// hooks run in reverse order, the background tasks stop before the pool closes
+	addBackgroundTasks(ctx, logger, shutdownHandler, rpstry, cfg)
+
+	svc := domain.NewAPISvc(
+		rpstry,
+		domain.WithPasswordHasher(domain.NewPasswordHasher(passwordParams)),
+		domain.WithRefreshTokenTTL(cfg.Security.RefreshTokenTTL),
+		domain.WithRevocationCacheTTL(cfg.Security.RevocationCacheTTL),
+		domain.WithCursorSecret([]byte(cfg.Security.CursorSecret)),
+		domain.WithBodyHTMLCacheTTL(cfg.Articles.BodyHTMLCacheTTL),
+		domain.WithMaxCommentDepth(cfg.Comments.MaxDepth),
+		domain.WithCommentEditWindow(cfg.Comments.EditWindow),
+	)
+
+	jwtKeys, errK := newJWTKeys(cfg)
+	if errK != nil {
+		return nil, fmt.Errorf("failed to load jwt keys: %w", errK)
+	}
 
 	// add the openapi http handler and healthchecks on the server
 	rtr, errCR := httpapi.CreateRouter(
@@ -125,7 +248,9 @@ func newAPIServer(
 		svc,
 		logger,
 		cfg.WithDebugProfiler,
-		cfg.Security.JWTSecret,
+		jwtKeys,
+		cfg.Security.AccessTokenTTL,
+		cfg.LegacyErrorFormat,
 	)
 	if errCR != nil {
 		return nil, fmt.Errorf("failed to create router: %w", errCR)
@@ -149,6 +274,55 @@ func newAPIServer(
 	}, nil
 }
 
+// addBackgroundTasks runs the periodic maintenance of the repository until shutdown.
+func addBackgroundTasks(
+	ctx context.Context,
+	logger *slog.Logger,
+	shutdownHandler *shutdown.Shutdown,
+	rpstry *db.Repository,
+	cfg *Config,
+) {
+	tasks := []struct {
+		name     string
+		interval time.Duration
+		run      func(ctx context.Context) (int64, error)
+	}{
+		{
+			name:     "scheduled publication",
+			interval: cfg.Articles.PublishInterval,
+			run:      rpstry.PublishScheduledArticles,
+		},
+		{
+			name:     "trash purge",
+			interval: cfg.Articles.PurgeInterval,
+			run: func(ctx context.Context) (int64, error) {
+				return rpstry.PurgeTrashedArticles(ctx, cfg.Articles.TrashRetention)
+			},
+		},
+		{
+			name:     "tag garbage collection",
+			interval: cfg.Tags.GCInterval,
+			run:      rpstry.DeleteUnusedTags,
+		},
+	}
+
+	for _, task := range tasks {
+		shutdownHandler.Add(
+			task.name,
+			cmd.RunPeriodically(ctx, logger, task.name, task.interval, func(ctx context.Context) error {
+				count, err := task.run(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to run %s: %w", task.name, err)
+				}
+
+				logger.DebugContext(ctx, task.name+" done", slog.Int64("count", count))
+
+				return nil
+			}),
+		)
+	}
+}
+
 func (api *apiServer) Serve(ctx context.Context) error {
 	if err := cmd.StartOtel(ctx, &api.cfg.BasicConfig, api.shutdownHandler, api.logger); err != nil {
 		return fmt.Errorf("cmd.StartOtel(): %w", err)
@@ -0,0 +1,16 @@
+CREATE TABLE refresh_token(
+    id uuid PRIMARY KEY,
+    family_id uuid NOT NULL,
+    appuser_id uuid NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    expires_at timestamptz NOT NULL,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (appuser_id) REFERENCES appuser(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- create index for family_id, used when a reused token revokes its whole family
+CREATE INDEX refresh_token_family_id_idx ON refresh_token(family_id);
+
+-- create index for appuser_id
+CREATE INDEX refresh_token_appuser_id_idx ON refresh_token(appuser_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS revoked_token;
+
+ALTER TABLE appuser DROP COLUMN IF EXISTS tokens_revoked_before;
@@ -0,0 +1,13 @@
+-- access tokens issued before this time are revoked, set when logging out everywhere
+ALTER TABLE appuser ADD COLUMN tokens_revoked_before timestamptz;
+
+CREATE TABLE revoked_token(
+    jti uuid PRIMARY KEY,
+    appuser_id uuid NOT NULL,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (appuser_id) REFERENCES appuser(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- create index for expires_at, used to purge tokens that expired anyway
+CREATE INDEX revoked_token_expires_at_idx ON revoked_token(expires_at);
@@ -0,0 +1,14 @@
+CREATE TABLE personal_access_token(
+    id uuid PRIMARY KEY,
+    appuser_id uuid NOT NULL,
+    name text NOT NULL,
+    scopes text[] NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    expires_at timestamptz NOT NULL,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (appuser_id) REFERENCES appuser(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- create index for appuser_id
+CREATE INDEX personal_access_token_appuser_id_idx ON personal_access_token(appuser_id);
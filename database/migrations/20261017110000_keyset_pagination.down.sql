@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS comment_article_id_created_at_id_idx;
+
+DROP INDEX IF EXISTS article_author_id_created_at_id_idx;
+
+DROP INDEX IF EXISTS article_created_at_id_idx;
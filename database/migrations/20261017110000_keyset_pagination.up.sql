@@ -0,0 +1,8 @@
+-- keyset pagination of the article listings, newest first
+CREATE INDEX article_created_at_id_idx ON article(created_at DESC, id DESC);
+
+-- keyset pagination of the articles of an author and of the feed
+CREATE INDEX article_author_id_created_at_id_idx ON article(author_id, created_at DESC, id DESC);
+
+-- keyset pagination of the comments of an article, oldest first
+CREATE INDEX comment_article_id_created_at_id_idx ON comment(article_id, created_at, id);
@@ -0,0 +1,12 @@
+-- language of the full-text search, set by the repository from the deployment config
+ALTER TABLE article ADD COLUMN search_language regconfig NOT NULL DEFAULT 'english';
+
+-- weighted document: title (A), description (B), body (C)
+ALTER TABLE article ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector(search_language, title), 'A') ||
+    setweight(to_tsvector(search_language, description), 'B') ||
+    setweight(to_tsvector(search_language, body), 'C')
+) STORED;
+
+-- create gin index for the full-text search
+CREATE INDEX article_search_vector_idx ON article USING GIN (search_vector);
@@ -0,0 +1,14 @@
+-- every slug an article has had, the current one included, so that a slug
+-- is never reused by another article and former slugs redirect to the current one
+CREATE TABLE article_slug_history(
+    slug text PRIMARY KEY,
+    article_id uuid NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (article_id) REFERENCES article(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- create index for article_id
+CREATE INDEX article_slug_history_article_id_idx ON article_slug_history(article_id);
+
+INSERT INTO article_slug_history (slug, article_id, created_at)
+SELECT slug, id, created_at FROM article;
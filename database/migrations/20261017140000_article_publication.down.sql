@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS article_publish_at_idx;
+
+ALTER TABLE article DROP COLUMN IF EXISTS publish_at;
+
+ALTER TABLE article DROP COLUMN IF EXISTS published_at;
@@ -0,0 +1,12 @@
+-- an article is published once published_at is set, a draft otherwise,
+-- and a scheduled draft is published by the api at publish_at
+ALTER TABLE article ADD COLUMN published_at timestamptz;
+
+ALTER TABLE article ADD COLUMN publish_at timestamptz;
+
+-- the existing articles were published on creation
+UPDATE article SET published_at = created_at;
+
+-- create index for the scheduled articles
+CREATE INDEX article_publish_at_idx ON article(publish_at)
+WHERE published_at IS NULL AND publish_at IS NOT NULL;
@@ -0,0 +1,21 @@
+-- every version of the content of an article, numbered from 1 on its creation,
+-- with the user who wrote it
+CREATE TABLE article_revision(
+    article_id uuid NOT NULL,
+    number int NOT NULL,
+    title text NOT NULL,
+    description text NOT NULL,
+    body text NOT NULL,
+    editor_id uuid,
+    created_at timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY (article_id, number),
+    FOREIGN KEY (article_id) REFERENCES article(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (editor_id) REFERENCES appuser(id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+-- create index for editor_id
+CREATE INDEX article_revision_editor_id_idx ON article_revision(editor_id);
+
+-- the former versions are lost, the current one is the first revision
+INSERT INTO article_revision (article_id, number, title, description, body, editor_id, created_at)
+SELECT id, 1, title, description, body, author_id, updated_at FROM article;
@@ -0,0 +1,3 @@
+ALTER TABLE appuser DROP COLUMN IF EXISTS version;
+
+ALTER TABLE article DROP COLUMN IF EXISTS version;
@@ -0,0 +1,5 @@
+-- the version of an article or a user is incremented by each of its updates,
+-- the api derives the entity tags of the optimistic concurrency from it
+ALTER TABLE article ADD COLUMN version integer NOT NULL DEFAULT 1;
+
+ALTER TABLE appuser ADD COLUMN version integer NOT NULL DEFAULT 1;
@@ -0,0 +1,5 @@
+ALTER TABLE article DROP COLUMN IF EXISTS table_of_contents;
+
+ALTER TABLE article DROP COLUMN IF EXISTS reading_time;
+
+ALTER TABLE article DROP COLUMN IF EXISTS word_count;
@@ -0,0 +1,13 @@
+-- the metadata of an article is computed by the api from its markdown body when it is written
+ALTER TABLE article ADD COLUMN word_count integer NOT NULL DEFAULT 0;
+
+ALTER TABLE article ADD COLUMN reading_time integer NOT NULL DEFAULT 0;
+
+ALTER TABLE article ADD COLUMN table_of_contents jsonb NOT NULL DEFAULT '[]';
+
+-- estimate the existing articles from their raw bodies at 200 words per minute,
+-- their table of contents is computed when they are next updated
+UPDATE article SET word_count = COALESCE(ARRAY_LENGTH(REGEXP_SPLIT_TO_ARRAY(TRIM(body), '\s+'), 1), 0)
+WHERE TRIM(body) <> '';
+
+UPDATE article SET reading_time = CEIL(word_count / 200.0);
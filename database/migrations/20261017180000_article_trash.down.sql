@@ -0,0 +1,6 @@
+-- the trashed articles are gone for good
+DELETE FROM article WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS article_deleted_at_idx;
+
+ALTER TABLE article DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,6 @@
+-- a deleted article stays in the trash of its author, with its comments, favorites
+-- and tags, until it is restored or purged
+ALTER TABLE article ADD COLUMN deleted_at timestamptz;
+
+-- create index for the purge of the trash
+CREATE INDEX article_deleted_at_idx ON article(deleted_at) WHERE deleted_at IS NOT NULL;
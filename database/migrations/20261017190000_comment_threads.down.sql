@@ -0,0 +1,8 @@
+-- the replies are detached before the placeholders are deleted, not to cascade to them
+ALTER TABLE comment DROP COLUMN IF EXISTS parent_id;
+
+ALTER TABLE comment DROP COLUMN IF EXISTS depth;
+
+DELETE FROM comment WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE comment DROP COLUMN IF EXISTS deleted_at;
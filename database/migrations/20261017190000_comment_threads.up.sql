@@ -0,0 +1,11 @@
+-- a comment can reply to another comment of the same article, its depth is one more
+-- than the one of its parent, from 0 for the comments of the article
+ALTER TABLE comment ADD COLUMN parent_id int REFERENCES comment(id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE comment ADD COLUMN depth int NOT NULL DEFAULT 0;
+
+-- a deleted comment with replies is kept as a placeholder, without its body
+ALTER TABLE comment ADD COLUMN deleted_at timestamptz;
+
+-- create index for parent_id
+CREATE INDEX comment_parent_id_idx ON comment(parent_id);
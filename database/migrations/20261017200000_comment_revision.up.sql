@@ -0,0 +1,10 @@
+-- the former bodies of a comment, numbered from 1 on its first edit,
+-- each dated by when it was written
+CREATE TABLE comment_revision(
+    comment_id int NOT NULL,
+    number int NOT NULL,
+    body text NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (comment_id, number),
+    FOREIGN KEY (comment_id) REFERENCES comment(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
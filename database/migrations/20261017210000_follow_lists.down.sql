@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS appuser_follows_follower_id_created_at_idx;
+
+DROP INDEX IF EXISTS appuser_follows_followee_id_created_at_idx;
@@ -0,0 +1,5 @@
+-- keyset pagination of the followers of a user, latest first
+CREATE INDEX appuser_follows_followee_id_created_at_idx ON appuser_follows(followee_id, created_at DESC, follower_id DESC);
+
+-- keyset pagination of the users a user follows, latest first
+CREATE INDEX appuser_follows_follower_id_created_at_idx ON appuser_follows(follower_id, created_at DESC, followee_id DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS article_author_id_published_at_id_idx;
+
+DROP INDEX IF EXISTS article_published_at_id_idx;
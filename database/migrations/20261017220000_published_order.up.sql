@@ -0,0 +1,7 @@
+-- keyset pagination of the published articles, latest published first
+CREATE INDEX article_published_at_id_idx ON article(published_at DESC, id DESC)
+WHERE published_at IS NOT NULL AND deleted_at IS NULL;
+
+-- keyset pagination of the published articles of an author and of the feed
+CREATE INDEX article_author_id_published_at_id_idx ON article(author_id, published_at DESC, id DESC)
+WHERE published_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// RunPeriodically runs the task in the background every interval, a failed run is logged
+// and retried on the next tick. The returned function stops it, waiting for a running task,
+// and can be added to the shutdown handler. A non positive interval disables the task.
+func RunPeriodically(
+	ctx context.Context,
+	logger *slog.Logger,
+	name string,
+	interval time.Duration,
+	task func(ctx context.Context) error,
+) func(ctx context.Context) error {
+	if interval <= 0 {
+		logger.WarnContext(ctx, "periodic task disabled", slog.String("task", name))
+
+		return func(_ context.Context) error { return nil }
+	}
+
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-runCtx.Done():
+				return
+			case <-ticker.C:
+				if err := task(runCtx); err != nil {
+					logger.ErrorContext(runCtx, "periodic task failed", slog.String("task", name), slog.Any("err", err))
+				}
+			}
+		}
+	}()
+
+	return func(ctx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("could not stop %s: %w", name, ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errTask = errors.New("task failed")
+
+func TestRunPeriodically(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var runs atomic.Int32
+
+	stop := RunPeriodically(t.Context(), logger, "test", time.Millisecond, func(_ context.Context) error {
+		runs.Add(1)
+
+		return errTask
+	})
+
+	// a failed run does not stop the next ones
+	deadline := time.Now().Add(time.Second)
+	for runs.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if err := stop(t.Context()); err != nil {
+		t.Fatalf("stop() error = %v", err)
+	}
+
+	if got := runs.Load(); got < 2 {
+		t.Errorf("RunPeriodically() ran %d times, want at least 2", got)
+	}
+
+	stopped := runs.Load()
+
+	time.Sleep(5 * time.Millisecond)
+
+	if got := runs.Load(); got != stopped {
+		t.Errorf("RunPeriodically() ran %d times after stop, want %d", got, stopped)
+	}
+}
+
+func TestRunPeriodically_stopTimeout(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	stop := RunPeriodically(t.Context(), logger, "test", time.Millisecond, func(_ context.Context) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+
+		<-release
+
+		return nil
+	})
+
+	<-started
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if err := stop(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("stop() error = %v, want %v", err, context.Canceled)
+	}
+
+	close(release)
+
+	if err := stop(t.Context()); err != nil {
+		t.Errorf("stop() error = %v", err)
+	}
+}
@@ -2,12 +2,25 @@ package domain
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gosimple/slug"
 )
 
+var (
+	ErrInvalidArticleFilter   = fmt.Errorf("%w: invalid article filter", ErrValidation)
+	ErrInvalidSearchQuery     = fmt.Errorf("%w: invalid search query", ErrValidation)
+	ErrSlugTaken              = fmt.Errorf("%w: slug taken", ErrConflict)
+	ErrInvalidPublishAt       = fmt.Errorf("%w: invalid publication time", ErrValidation)
+	ErrTrashedArticleNotFound = fmt.Errorf("%w: trashed article", ErrNotFound)
+	ErrArticlePublished       = fmt.Errorf("%w: article already published", ErrConflict)
+)
+
 type Tag string
 
 //nolint:iface //for extension
@@ -26,21 +39,273 @@ type Article struct {
 	Title          string    `db:"title" json:"title"`
 	Description    string    `db:"description" json:"description"`
 	Body           string    `db:"body" json:"body"`
+	BodyHTML       string    `db:"-" json:"-"` // rendered by the service
 	TagList        []Tag     `db:"tag_list" json:"tag_list"`
 	CreatedAt      time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+	Version        int       `db:"version" json:"version"`
 	Favorited      bool      `db:"favorited" json:"favorited"`
 	FavoritesCount int       `db:"favorites_count" json:"favorites_count"`
+	CommentsCount  int       `db:"comments_count" json:"comments_count"`
 	Author         Profile   `db:"author" json:"author"`
+
+	Status      ArticleStatus `db:"status" json:"status"`
+	PublishAt   *time.Time    `db:"publish_at" json:"publish_at"`
+	PublishedAt *time.Time    `db:"published_at" json:"published_at"`
+	// DeletedAt is set while the article is in the trash of its author
+	DeletedAt *time.Time `db:"deleted_at" json:"deleted_at"`
+
+	ArticleMetadata
+}
+
+// ArticleMetadata is computed from the body of an article when it is written,
+// and stored along with it so that the listings do not parse the bodies.
+type ArticleMetadata struct {
+	WordCount int `db:"word_count" json:"word_count"`
+	// ReadingTime is the estimated reading time, in minutes
+	ReadingTime     int        `db:"reading_time" json:"reading_time"`
+	TableOfContents []TOCEntry `db:"table_of_contents" json:"table_of_contents"`
+}
+
+// TOCEntry is a heading of the body of an article, Anchor is the id of the rendered heading.
+type TOCEntry struct {
+	Level  int    `json:"level"`
+	Text   string `json:"text"`
+	Anchor string `json:"anchor"`
+}
+
+// ArticleStatus is the publication state of an article. Only the published articles
+// are listed, the others are only visible to their author.
+type ArticleStatus string
+
+const (
+	ArticleStatusDraft     ArticleStatus = "draft"
+	ArticleStatusScheduled ArticleStatus = "scheduled"
+	ArticleStatusPublished ArticleStatus = "published"
+)
+
+// NewArticleStatus returns the status of a new article: scheduled when it has a publication time,
+// which must be in the future, a draft when asked, and published otherwise.
+func NewArticleStatus(draft bool, publishAt *time.Time, now time.Time) (ArticleStatus, error) {
+	if publishAt != nil {
+		if err := validatePublishAt(*publishAt, now); err != nil {
+			return "", err
+		}
+
+		return ArticleStatusScheduled, nil
+	}
+
+	if draft {
+		return ArticleStatusDraft, nil
+	}
+
+	return ArticleStatusPublished, nil
+}
+
+func validatePublishAt(publishAt, now time.Time) error {
+	if !publishAt.After(now) {
+		return NewFieldError("publishAt", "must be in the future", ErrInvalidPublishAt)
+	}
+
+	return nil
+}
+
+// ArticlePage is a page of articles, with the number of articles matching the filters.
+// NextCursor is empty on the last page.
+type ArticlePage struct {
+	Articles   []*Article
+	Total      int
+	NextCursor string
+}
+
+// ArticleSearchResult is an article matching a full-text search, with its rank and
+// its matches highlighted with <mark> in the html escaped title and snippet of the body.
+type ArticleSearchResult struct {
+	Article
+
+	Rank           float64 `db:"rank" json:"rank"`
+	TitleHighlight string  `db:"title_highlight" json:"title_highlight"`
+	Snippet        string  `db:"snippet" json:"snippet"`
+}
+
+// ArticleSearchPage is a page of search results, best ranked first, with the number of matches.
+type ArticleSearchPage struct {
+	Results []*ArticleSearchResult
+	Total   int
+}
+
+// Cursor returns the keyset position of the article in the listings,
+// which order the published articles by their publication.
+func (a *Article) Cursor() Cursor {
+	if a.PublishedAt != nil {
+		return Cursor{CreatedAt: *a.PublishedAt, ID: a.ID.String()}
+	}
+
+	return Cursor{CreatedAt: a.CreatedAt, ID: a.ID.String()}
+}
+
+// ArticleSort is the order of the article listings.
+type ArticleSort string
+
+const (
+	ArticleSortNewest        ArticleSort = "newest"
+	ArticleSortOldest        ArticleSort = "oldest"
+	ArticleSortMostFavorited ArticleSort = "most_favorited"
+	ArticleSortMostCommented ArticleSort = "most_commented"
+)
+
+// Keyset tells whether the sort is on (created_at, id), the only one cursors can page through.
+func (s ArticleSort) Keyset() bool {
+	return s == "" || s == ArticleSortNewest || s == ArticleSortOldest
+}
+
+// TagMatch tells whether an article must have any or all of the filtered tags.
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+// ArticleFilter narrows and orders the article listings, its zero value lists
+// every article, newest first.
+type ArticleFilter struct {
+	Tags          []string
+	TagMatch      TagMatch
+	ExcludedTags  []string
+	Authors       []string
+	Favorited     *string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	Sort          ArticleSort
+}
+
+func (f *ArticleFilter) validate() error {
+	var errs []error
+
+	switch f.Sort {
+	case "", ArticleSortNewest, ArticleSortOldest, ArticleSortMostFavorited, ArticleSortMostCommented:
+	default:
+		errs = append(errs, NewFieldError("sort", "unknown sort "+string(f.Sort), ErrInvalidArticleFilter))
+	}
+
+	switch f.TagMatch {
+	case "", TagMatchAny, TagMatchAll:
+	default:
+		errs = append(errs, NewFieldError("tagMatch", "unknown match "+string(f.TagMatch), ErrInvalidArticleFilter))
+	}
+
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && f.CreatedAfter.After(*f.CreatedBefore) {
+		errs = append(errs, NewFieldError("createdAfter", "is after createdBefore", ErrInvalidArticleFilter))
+	}
+
+	if f.UpdatedAfter != nil && f.UpdatedBefore != nil && f.UpdatedAfter.After(*f.UpdatedBefore) {
+		errs = append(errs, NewFieldError("updatedAfter", "is after updatedBefore", ErrInvalidArticleFilter))
+	}
+
+	return errors.Join(errs...)
 }
 
 func GetSlugFromTitle(title string) string {
 	return slug.Make(title)
 }
 
+const (
+	// a new suffix is drawn on each collision, the last attempt fails with ErrSlugTaken
+	maxSlugAttempts  = 5
+	slugSuffixLength = 6
+)
+
+// SlugCandidate returns the slug to try for a title, the plain slug first
+// and then the slug with a short random suffix.
+func SlugCandidate(title string, attempt int) string {
+	base := GetSlugFromTitle(title)
+	if attempt == 0 {
+		return base
+	}
+
+	return base + "-" + strings.ToLower(rand.Text()[:slugSuffixLength])
+}
+
+// TitleKeepsSlug tells whether the new title of an article makes the same slug as its stored title,
+// so that an update keeping the title, give or take its punctuation, does not draw a new slug.
+// The slug itself is not parsed, a word of the title can't be told apart from a random suffix.
+func TitleKeepsSlug(storedTitle, title string) bool {
+	return GetSlugFromTitle(storedTitle) == GetSlugFromTitle(title)
+}
+
+// ArticleMovedError is returned for a former slug of an article, Slug being the current one.
+// It is a not found error for the callers that do not redirect.
+type ArticleMovedError struct {
+	Slug string
+}
+
+func (e *ArticleMovedError) Error() string {
+	return "article moved to " + e.Slug
+}
+
+func (e *ArticleMovedError) Unwrap() error {
+	return ErrNotFound
+}
+
+// CanEditArticle only allows the author of an article to update or delete it.
+func CanEditArticle(userID, authorID uuid.UUID) error {
+	if userID == uuid.Nil || userID != authorID {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
 //nolint:iface //for extension
 type ArticleService interface {
-	ArticleRepository
+	GetArticles(
+		ctx context.Context,
+		userID uuid.UUID,
+		filter ArticleFilter,
+		limit, offset *int,
+		cursor *string,
+	) (*ArticlePage, error)
+	GetArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
+	SearchArticles(
+		ctx context.Context,
+		userID uuid.UUID,
+		query string,
+		limit, offset *int,
+	) (*ArticleSearchPage, error)
+	GetFeedArticles(
+		ctx context.Context,
+		userID uuid.UUID,
+		filter ArticleFilter,
+		limit, offset *int,
+		cursor *string,
+	) (*ArticlePage, error)
+	CreateArticle(
+		ctx context.Context,
+		userID uuid.UUID,
+		title, description, body string,
+		tagList []string,
+		draft bool,
+		publishAt *time.Time,
+	) (*Article, error)
+	UpdateArticle(
+		ctx context.Context,
+		userID uuid.UUID,
+		slug string,
+		title, description, body *string,
+		tagList *[]string,
+		expectedVersions []int,
+	) (*Article, error)
+	DeleteArticle(ctx context.Context, userID uuid.UUID, slug string) error
+	GetDraftArticles(ctx context.Context, userID uuid.UUID, limit, offset *int) (*ArticlePage, error)
+	GetTrashedArticles(ctx context.Context, userID uuid.UUID, limit, offset *int) (*ArticlePage, error)
+	RestoreArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
+	PublishArticle(ctx context.Context, userID uuid.UUID, slug string, publishAt *time.Time) (*Article, error)
+	UnpublishArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
+	FavoriteArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
+	UnfavoriteArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
 }
 
 //nolint:iface //for extension
@@ -48,29 +313,50 @@ type ArticleRepository interface {
 	GetArticles(
 		ctx context.Context,
 		userID uuid.UUID,
-		author, tag, favorited *string,
+		filter ArticleFilter,
 		limit, offset *int,
-	) ([]*Article, error)
+		after *Cursor,
+	) (*ArticlePage, error)
 	GetArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
+	SearchArticles(
+		ctx context.Context,
+		userID uuid.UUID,
+		query string,
+		limit, offset *int,
+	) (*ArticleSearchPage, error)
+	GetArticleAuthorID(ctx context.Context, slug string) (uuid.UUID, error)
 	GetFeedArticles(
 		ctx context.Context,
 		userID uuid.UUID,
-		username, tag, favorited *string,
+		filter ArticleFilter,
 		limit, offset *int,
-	) ([]*Article, error)
+		after *Cursor,
+	) (*ArticlePage, error)
+	GetArticleCurrentSlug(ctx context.Context, userID uuid.UUID, slug string) (string, error)
 	CreateArticle(
 		ctx context.Context,
 		userID uuid.UUID,
-		title, description, body string,
+		slug, title, description, body string,
 		tagList []string,
+		status ArticleStatus,
+		publishAt *time.Time,
+		metadata ArticleMetadata,
 	) (*Article, error)
 	UpdateArticle(
 		ctx context.Context,
 		userID uuid.UUID,
 		slug string,
-		title, description, body *string,
+		newSlug, title, description, body *string,
+		tagList *[]string,
+		metadata *ArticleMetadata,
+		expectedVersions []int,
 	) (*Article, error)
 	DeleteArticle(ctx context.Context, userID uuid.UUID, slug string) error
+	GetDraftArticles(ctx context.Context, userID uuid.UUID, limit, offset *int) (*ArticlePage, error)
+	GetTrashedArticles(ctx context.Context, userID uuid.UUID, limit, offset *int) (*ArticlePage, error)
+	RestoreArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
+	PublishArticle(ctx context.Context, userID uuid.UUID, slug string, publishAt *time.Time) (*Article, error)
+	UnpublishArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
 	FavoriteArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
 	UnfavoriteArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error)
 }
@@ -1,6 +1,13 @@
 package domain
 
-import "testing"
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 func TestGetSlugFromTitle(t *testing.T) {
 	t.Parallel()
@@ -40,3 +47,240 @@ func TestGetSlugFromTitle(t *testing.T) {
 		})
 	}
 }
+
+func TestCanEditArticle(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		wantErr error
+	}{
+		{
+			name:    "author",
+			userID:  authorID,
+			wantErr: nil,
+		},
+		{
+			name:    "someone else",
+			userID:  uuid.Must(uuid.NewV7()),
+			wantErr: ErrForbidden,
+		},
+		{
+			name:    "anonymous",
+			userID:  uuid.Nil,
+			wantErr: ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := CanEditArticle(tt.userID, authorID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CanEditArticle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestArticleFilter_validate(t *testing.T) {
+	t.Parallel()
+
+	early := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := early.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		filter  ArticleFilter
+		wantErr bool
+	}{
+		{
+			name:    "zero value",
+			filter:  ArticleFilter{},
+			wantErr: false,
+		},
+		{
+			name: "all tags, most commented, in range",
+			filter: ArticleFilter{
+				Tags:          []string{"dragons"},
+				TagMatch:      TagMatchAll,
+				CreatedAfter:  &early,
+				CreatedBefore: &late,
+				Sort:          ArticleSortMostCommented,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "unknown sort",
+			filter:  ArticleFilter{Sort: "random"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown tag match",
+			filter:  ArticleFilter{TagMatch: "none"},
+			wantErr: true,
+		},
+		{
+			name:    "inverted range",
+			filter:  ArticleFilter{UpdatedAfter: &late, UpdatedBefore: &early},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.filter.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ArticleFilter.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("ArticleFilter.validate() error = %v, want a validation error", err)
+			}
+		})
+	}
+}
+
+func TestSlugCandidate(t *testing.T) {
+	t.Parallel()
+
+	title := "How to train your dragon"
+
+	if got := SlugCandidate(title, 0); got != "how-to-train-your-dragon" {
+		t.Errorf("SlugCandidate() = %v, want the plain slug", got)
+	}
+
+	first, second := SlugCandidate(title, 1), SlugCandidate(title, 2)
+	if first == second {
+		t.Errorf("SlugCandidate() = %v twice, want a new suffix per attempt", first)
+	}
+
+	for _, candidate := range []string{first, second} {
+		if !strings.HasPrefix(candidate, "how-to-train-your-dragon-") {
+			t.Errorf("SlugCandidate() = %v, want the suffixed slug", candidate)
+		}
+
+		if candidate != GetSlugFromTitle(candidate) {
+			t.Errorf("SlugCandidate() = %v, is not a slug", candidate)
+		}
+	}
+}
+
+func TestTitleKeepsSlug(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		storedTitle string
+		title       string
+		want        bool
+	}{
+		{
+			name:        "same title",
+			storedTitle: "Hello",
+			title:       "Hello",
+			want:        true,
+		},
+		{
+			name:        "punctuation only",
+			storedTitle: "Hello",
+			title:       "Hello!",
+			want:        true,
+		},
+		{
+			name:        "renamed",
+			storedTitle: "Hello",
+			title:       "World",
+			want:        false,
+		},
+		{
+			name:        "longer title sharing the prefix",
+			storedTitle: "Hello",
+			title:       "Hello World",
+			want:        false,
+		},
+		{
+			name:        "shorter title, the last word is not a suffix",
+			storedTitle: "Go Tricks",
+			title:       "Go",
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := TitleKeepsSlug(tt.storedTitle, tt.title); got != tt.want {
+				t.Errorf("TitleKeepsSlug() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewArticleStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		draft     bool
+		publishAt *time.Time
+		want      ArticleStatus
+		wantErr   error
+	}{
+		{
+			name: "published",
+			want: ArticleStatusPublished,
+		},
+		{
+			name:  "draft",
+			draft: true,
+			want:  ArticleStatusDraft,
+		},
+		{
+			name:      "scheduled",
+			publishAt: &future,
+			want:      ArticleStatusScheduled,
+		},
+		{
+			name:      "scheduled draft",
+			draft:     true,
+			publishAt: &future,
+			want:      ArticleStatusScheduled,
+		},
+		{
+			name:      "scheduled in the past",
+			publishAt: &past,
+			wantErr:   ErrInvalidPublishAt,
+		},
+		{
+			name:      "scheduled now",
+			publishAt: &now,
+			wantErr:   ErrInvalidPublishAt,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewArticleStatus(tt.draft, tt.publishAt, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewArticleStatus() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("NewArticleStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
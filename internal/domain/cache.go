@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"sync"
+	"time"
+)
+
+type ttlCacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// ttlCache is an in memory cache whose entries expire after a fixed ttl.
+// Expired entries are evicted when read, and swept at most once per ttl on write,
+// so that no background goroutine is needed.
+type ttlCache[K comparable, V any] struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	entries   map[K]ttlCacheEntry[V]
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{
+		ttl:     ttl,
+		entries: make(map[K]ttlCacheEntry[V]),
+		now:     time.Now,
+	}
+}
+
+func (c *ttlCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		var zero V
+
+		return zero, false
+	}
+
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+
+		var zero V
+
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+func (c *ttlCache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+
+		c.lastSweep = now
+	}
+
+	c.entries[key] = ttlCacheEntry[V]{
+		value:     value,
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+func (c *ttlCache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+func (c *ttlCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cache := newTTLCache[string, int](time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", 1)
+
+	if got, ok := cache.Get("a"); !ok || got != 1 {
+		t.Errorf("ttlCache.Get() = %v, %v, want 1, true", got, ok)
+	}
+
+	cache.Delete("a")
+
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("ttlCache.Get() after Delete() should miss")
+	}
+
+	cache.Set("b", 2)
+
+	now = now.Add(time.Minute)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("ttlCache.Get() of an expired entry should miss")
+	}
+
+	cache.Set("c", 3)
+
+	now = now.Add(2 * time.Minute)
+
+	// the write sweeps the expired entries
+	cache.Set("d", 4)
+
+	if got := cache.Len(); got != 1 {
+		t.Errorf("ttlCache.Len() = %v, want 1", got)
+	}
+}
@@ -2,27 +2,148 @@ package domain
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrCommentTooDeep          = fmt.Errorf("%w: comment nested too deep", ErrValidation)
+	ErrCommentEditWindowClosed = fmt.Errorf("%w: comment edit window closed", ErrForbidden)
+	ErrInvalidCommentSort      = fmt.Errorf("%w: invalid comment sort", ErrValidation)
+)
+
+// DeletedCommentBody is the body of the placeholder left by a deleted comment
+// that has replies, which keeps the thread in place.
+const DeletedCommentBody = "[deleted]"
+
+// Comment is a comment of an article, or a reply to another one when ParentID is set.
+// Depth is 0 for the comments of the article, 1 for their replies, and so on.
+// Edited is set once its body was updated, the former bodies are kept as revisions.
 type Comment struct {
 	ID        int       `db:"id" json:"id"`
+	ParentID  *int      `db:"parent_id" json:"parent_id"`
+	Depth     int       `db:"depth" json:"depth"`
 	Body      string    `db:"body" json:"body"`
+	Deleted   bool      `db:"deleted" json:"deleted"`
+	Edited    bool      `db:"edited" json:"edited"`
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 	Author    Profile   `db:"author" json:"author"`
 }
 
+// CommentSort is the order of the threads of comments, the replies are always in the order
+// they were written.
+type CommentSort string
+
+const (
+	CommentSortOldest CommentSort = "oldest"
+	CommentSortNewest CommentSort = "newest"
+	// CommentSortTop puts first the comments with the most replies
+	CommentSortTop CommentSort = "top"
+)
+
+// Keyset tells whether the sort is on (created_at, id), the only one cursors can page through.
+func (s CommentSort) Keyset() bool {
+	return s == "" || s == CommentSortOldest || s == CommentSortNewest
+}
+
+func (s CommentSort) validate() error {
+	switch s {
+	case "", CommentSortOldest, CommentSortNewest, CommentSortTop:
+		return nil
+	default:
+		return NewFieldError("sort", "unknown sort "+string(s), ErrInvalidCommentSort)
+	}
+}
+
+// CommentPage is a page of the threads of comments of an article, each comment followed by
+// its replies in the order they were written. NextCursor is empty on the last page.
+type CommentPage struct {
+	Comments   []*Comment
+	NextCursor string
+}
+
+// Cursor returns the keyset position of the comment in the listings.
+func (c *Comment) Cursor() Cursor {
+	return Cursor{CreatedAt: c.CreatedAt, ID: strconv.Itoa(c.ID)}
+}
+
+// CanReplyToComment allows replies down to maxDepth, where the comments of the article are at 0.
+func CanReplyToComment(parentDepth, maxDepth int) error {
+	if parentDepth+1 > maxDepth {
+		return fmt.Errorf("%w: replies are limited to a depth of %d", ErrCommentTooDeep, maxDepth)
+	}
+
+	return nil
+}
+
+// CommentAuthorship holds who wrote a comment and when, and the author of its article,
+// which is all the deletion and edition policies need to know.
+type CommentAuthorship struct {
+	CommentAuthorID uuid.UUID `db:"comment_author_id" json:"comment_author_id"`
+	ArticleAuthorID uuid.UUID `db:"article_author_id" json:"article_author_id"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
+}
+
+// CanDeleteComment allows the author of the comment and the author of the article
+// to delete a comment, and forbids everyone else.
+func CanDeleteComment(userID uuid.UUID, authorship *CommentAuthorship) error {
+	if userID == uuid.Nil {
+		return ErrForbidden
+	}
+
+	if userID != authorship.CommentAuthorID && userID != authorship.ArticleAuthorID {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// CanEditComment allows the author of the comment only to edit it,
+// during the window after it was posted.
+func CanEditComment(userID uuid.UUID, authorship *CommentAuthorship, window time.Duration, now time.Time) error {
+	if userID == uuid.Nil || userID != authorship.CommentAuthorID {
+		return ErrForbidden
+	}
+
+	if !now.Before(authorship.CreatedAt.Add(window)) {
+		return fmt.Errorf("%w: comments can be edited for %v after they are posted", ErrCommentEditWindowClosed, window)
+	}
+
+	return nil
+}
+
 //nolint:iface //for extension
 type CommentService interface {
-	CommentRepository
+	GetComments(
+		ctx context.Context,
+		userID uuid.UUID,
+		slug string,
+		sort CommentSort,
+		limit, offset *int,
+		cursor *string,
+	) (*CommentPage, error)
+	AddComment(ctx context.Context, authorID uuid.UUID, slug, body string, parentID *int) (*Comment, error)
+	UpdateComment(ctx context.Context, userID uuid.UUID, slug string, id int, body string) (*Comment, error)
+	DeleteComment(ctx context.Context, userID uuid.UUID, slug string, id int) error
 }
 
 //nolint:iface //for extension
 type CommentRepository interface {
-	GetComments(ctx context.Context, userID uuid.UUID, slug string) ([]*Comment, error)
-	AddComment(ctx context.Context, authorID uuid.UUID, slug, body string) (*Comment, error)
+	GetComments(
+		ctx context.Context,
+		userID uuid.UUID,
+		slug string,
+		sort CommentSort,
+		limit, offset *int,
+		after *Cursor,
+	) ([]*Comment, error)
+	AddComment(ctx context.Context, authorID uuid.UUID, slug, body string, parentID *int) (*Comment, error)
+	GetCommentDepth(ctx context.Context, slug string, id int) (int, error)
+	GetCommentAuthorship(ctx context.Context, slug string, id int) (*CommentAuthorship, error)
+	UpdateComment(ctx context.Context, slug string, id int, body string) (*Comment, error)
 	DeleteComment(ctx context.Context, slug string, id int) error
 }
@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCanDeleteComment(t *testing.T) {
+	t.Parallel()
+
+	commentAuthorID := uuid.Must(uuid.NewV7())
+	articleAuthorID := uuid.Must(uuid.NewV7())
+
+	authorship := &CommentAuthorship{
+		CommentAuthorID: commentAuthorID,
+		ArticleAuthorID: articleAuthorID,
+	}
+
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		wantErr error
+	}{
+		{
+			name:    "comment author",
+			userID:  commentAuthorID,
+			wantErr: nil,
+		},
+		{
+			name:    "article author",
+			userID:  articleAuthorID,
+			wantErr: nil,
+		},
+		{
+			name:    "someone else",
+			userID:  uuid.Must(uuid.NewV7()),
+			wantErr: ErrForbidden,
+		},
+		{
+			name:    "anonymous",
+			userID:  uuid.Nil,
+			wantErr: ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := CanDeleteComment(tt.userID, authorship); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CanDeleteComment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanReplyToComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		parentDepth int
+		maxDepth    int
+		wantErr     error
+	}{
+		{
+			name:        "reply to a comment of the article",
+			parentDepth: 0,
+			maxDepth:    2,
+			wantErr:     nil,
+		},
+		{
+			name:        "reply at the max depth",
+			parentDepth: 1,
+			maxDepth:    2,
+			wantErr:     nil,
+		},
+		{
+			name:        "reply beyond the max depth",
+			parentDepth: 2,
+			maxDepth:    2,
+			wantErr:     ErrCommentTooDeep,
+		},
+		{
+			name:        "replies disabled",
+			parentDepth: 0,
+			maxDepth:    0,
+			wantErr:     ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := CanReplyToComment(tt.parentDepth, tt.maxDepth); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CanReplyToComment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanEditComment(t *testing.T) {
+	t.Parallel()
+
+	commentAuthorID := uuid.Must(uuid.NewV7())
+	articleAuthorID := uuid.Must(uuid.NewV7())
+	postedAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	authorship := &CommentAuthorship{
+		CommentAuthorID: commentAuthorID,
+		ArticleAuthorID: articleAuthorID,
+		CreatedAt:       postedAt,
+	}
+
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		window  time.Duration
+		now     time.Time
+		wantErr error
+	}{
+		{
+			name:    "comment author within the window",
+			userID:  commentAuthorID,
+			window:  time.Hour,
+			now:     postedAt.Add(time.Minute),
+			wantErr: nil,
+		},
+		{
+			name:    "comment author after the window",
+			userID:  commentAuthorID,
+			window:  time.Hour,
+			now:     postedAt.Add(time.Hour),
+			wantErr: ErrCommentEditWindowClosed,
+		},
+		{
+			name:    "edits disabled",
+			userID:  commentAuthorID,
+			window:  0,
+			now:     postedAt,
+			wantErr: ErrForbidden,
+		},
+		{
+			name:    "article author",
+			userID:  articleAuthorID,
+			window:  time.Hour,
+			now:     postedAt,
+			wantErr: ErrForbidden,
+		},
+		{
+			name:    "anonymous",
+			userID:  uuid.Nil,
+			window:  time.Hour,
+			now:     postedAt,
+			wantErr: ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := CanEditComment(tt.userID, authorship, tt.window, tt.now); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CanEditComment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommentSort_validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		sort       CommentSort
+		wantErr    bool
+		wantKeyset bool
+	}{
+		{
+			name:       "default",
+			sort:       "",
+			wantErr:    false,
+			wantKeyset: true,
+		},
+		{
+			name:       "newest",
+			sort:       CommentSortNewest,
+			wantErr:    false,
+			wantKeyset: true,
+		},
+		{
+			name:       "top",
+			sort:       CommentSortTop,
+			wantErr:    false,
+			wantKeyset: false,
+		},
+		{
+			name:       "unknown sort",
+			sort:       "random",
+			wantErr:    true,
+			wantKeyset: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.sort.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CommentSort.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, ErrInvalidCommentSort) {
+				t.Errorf("CommentSort.validate() error = %v, want %v", err, ErrInvalidCommentSort)
+			}
+
+			if got := tt.sort.Keyset(); got != tt.wantKeyset {
+				t.Errorf("CommentSort.Keyset() = %v, want %v", got, tt.wantKeyset)
+			}
+		})
+	}
+}
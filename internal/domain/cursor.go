@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = fmt.Errorf("%w: invalid cursor", ErrValidation)
+
+// Cursor is the keyset position of a listing, the (date, id) of the last item of the previous page,
+// the date being the one the listing is ordered by, mostly the creation. The next page starts strictly after it.
+// The id is kept as a string so that articles (uuid) and comments (int) share it.
+type Cursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+// CursorCodec turns cursors into strings signed with HMAC-SHA256, so that clients cannot forge them.
+// The payload is encoded but not encrypted, it can be read and must not hold anything private.
+type CursorCodec struct {
+	secret []byte
+}
+
+func NewCursorCodec(secret []byte) *CursorCodec {
+	return &CursorCodec{secret: secret}
+}
+
+// Encode returns base64url(payload).base64url(signature).
+func (cc *CursorCodec) Encode(cursor Cursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(cc.sign(payload)), nil
+}
+
+// Decode verifies the signature of an encoded cursor and returns it.
+func (cc *CursorCodec) Decode(encoded string) (*Cursor, error) {
+	encPayload, encSignature, found := strings.Cut(encoded, ".")
+	if !found {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, errP := base64.RawURLEncoding.DecodeString(encPayload)
+	if errP != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, errP)
+	}
+
+	signature, errS := base64.RawURLEncoding.DecodeString(encSignature)
+	if errS != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, errS)
+	}
+
+	if !hmac.Equal(signature, cc.sign(payload)) {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	return &cursor, nil
+}
+
+func (cc *CursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, cc.secret)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
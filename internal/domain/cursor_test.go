@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCursorCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursorCodec([]byte("secret"))
+	cursor := Cursor{
+		CreatedAt: time.Date(2026, 10, 17, 9, 30, 0, 123456000, time.UTC),
+		ID:        "0192a6c4-5b7e-7c1a-9f3e-2d4b6a8c0e1f",
+	}
+
+	encoded, errE := codec.Encode(cursor)
+	if errE != nil {
+		t.Fatalf("CursorCodec.Encode() error = %v", errE)
+	}
+
+	payload, _, _ := strings.Cut(encoded, ".")
+
+	otherEncoded, errO := NewCursorCodec([]byte("other")).Encode(cursor)
+	if errO != nil {
+		t.Fatalf("CursorCodec.Encode() error = %v", errO)
+	}
+
+	_, otherSignature, _ := strings.Cut(otherEncoded, ".")
+
+	tests := []struct {
+		name    string
+		encoded string
+		wantErr bool
+	}{
+		{
+			name:    "round trip",
+			encoded: encoded,
+			wantErr: false,
+		},
+		{
+			name:    "signed with another secret",
+			encoded: otherEncoded,
+			wantErr: true,
+		},
+		{
+			name:    "tampered payload",
+			encoded: "x" + encoded,
+			wantErr: true,
+		},
+		{
+			name:    "forged signature",
+			encoded: payload + "." + otherSignature,
+			wantErr: true,
+		},
+		{
+			name:    "not a cursor",
+			encoded: "20",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := codec.Decode(tt.encoded)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CursorCodec.Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("CursorCodec.Decode() error = %v, want a validation error", err)
+				}
+
+				return
+			}
+
+			if !got.CreatedAt.Equal(cursor.CreatedAt) || got.ID != cursor.ID {
+				t.Errorf("CursorCodec.Decode() = %v, want %v", got, cursor)
+			}
+		})
+	}
+}
@@ -8,7 +8,16 @@ import (
 
 //nolint:iface //for extension
 type APIService interface {
-	APIRepository
+	ArticleService
+	ArticleRevisionService
+	ProfileService
+	TagService
+	UserService
+	RefreshTokenService
+	TokenRevocationService
+	PersonalAccessTokenService
+	CommentService
+	MarkdownService
 	GetShutdownFuncs() map[string]func(ctx context.Context) error
 	GetHealthChecks() []health.CheckConfig
 }
@@ -16,9 +25,13 @@ type APIService interface {
 //nolint:iface //for extension
 type APIRepository interface {
 	ArticleRepository
+	ArticleRevisionRepository
 	ProfileRepository
 	TagRepository
 	UserRepository
+	RefreshTokenRepository
+	TokenRevocationRepository
+	PersonalAccessTokenRepository
 	CommentRepository
 	GetShutdownFuncs() map[string]func(ctx context.Context) error
 	GetHealthChecks() []health.CheckConfig
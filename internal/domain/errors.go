@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors classifying the failures of the service, the more specific
+// errors wrap one of them so that callers can match either.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrForbidden          = errors.New("forbidden")
+	ErrConflict           = errors.New("conflict")
+	ErrValidation         = errors.New("validation failed")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
+
+// FieldError is the validation error of a single input field.
+// It wraps Err, which should itself wrap ErrValidation.
+type FieldError struct {
+	Field  string
+	Reason string
+	Err    error
+}
+
+func NewFieldError(field, reason string, err error) *FieldError {
+	return &FieldError{
+		Field:  field,
+		Reason: reason,
+		Err:    err,
+	}
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Reason)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
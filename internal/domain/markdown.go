@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// wordsPerMinute is the reading speed the reading time of the articles is estimated at.
+const wordsPerMinute = 200
+
+//nolint:iface //for extension
+type MarkdownService interface {
+	RenderMarkdown(ctx context.Context, source string) (string, error)
+}
+
+// MarkdownRenderer renders markdown to html that is safe to embed in a page:
+// CommonMark with the GitHub extensions, then sanitized against an allow-list.
+// The headings get the ids the table of contents of the metadata links to.
+// It is safe for concurrent use.
+type MarkdownRenderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+}
+
+func NewMarkdownRenderer() *MarkdownRenderer {
+	policy := bluemonday.UGCPolicy()
+
+	// the checkboxes of the task lists
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+
+	return &MarkdownRenderer{
+		markdown: goldmark.New(
+			goldmark.WithExtensions(extension.GFM),
+			goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		),
+		policy: policy,
+	}
+}
+
+func (mr *MarkdownRenderer) Render(source string) (string, error) {
+	var buf bytes.Buffer
+	if err := mr.markdown.Convert([]byte(source), &buf); err != nil {
+		return "", fmt.Errorf("could not convert markdown: %w", err)
+	}
+
+	return mr.policy.SanitizeReader(&buf).String(), nil
+}
+
+// Metadata computes the metadata of an article body.
+func (mr *MarkdownRenderer) Metadata(source string) ArticleMetadata {
+	src := []byte(source)
+	doc := mr.markdown.Parser().Parse(text.NewReader(src))
+
+	metadata := ArticleMetadata{
+		TableOfContents: []TOCEntry{},
+	}
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := node.(type) {
+		case *ast.Heading:
+			anchor, _ := n.AttributeString("id")
+			anchorBytes, _ := anchor.([]byte)
+
+			metadata.TableOfContents = append(metadata.TableOfContents, TOCEntry{
+				Level:  n.Level,
+				Text:   plainText(n, src),
+				Anchor: string(anchorBytes),
+			})
+		case *ast.Text:
+			metadata.WordCount += len(strings.Fields(string(n.Value(src))))
+		case *ast.AutoLink:
+			metadata.WordCount++
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			lines := n.Lines()
+			for i := range lines.Len() {
+				line := lines.At(i)
+				metadata.WordCount += len(strings.Fields(string(line.Value(src))))
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	metadata.ReadingTime = (metadata.WordCount + wordsPerMinute - 1) / wordsPerMinute
+
+	return metadata
+}
+
+// plainText is the text of an inline content, without its markup.
+func plainText(node ast.Node, src []byte) string {
+	var buf strings.Builder
+
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch c := child.(type) {
+		case *ast.Text:
+			buf.Write(c.Value(src))
+
+			if c.SoftLineBreak() || c.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(c.Value)
+		case *ast.AutoLink:
+			buf.Write(c.Label(src))
+		default:
+			buf.WriteString(plainText(c, src))
+		}
+	}
+
+	return buf.String()
+}
+
+// articleVersionKey identifies a version of an article, whose body never changes.
+type articleVersionKey struct {
+	articleID uuid.UUID
+	version   int
+}
+
+// RenderMarkdown renders the markdown the way the bodies of the articles are.
+func (as *APISvc) RenderMarkdown(_ context.Context, source string) (string, error) {
+	html, err := as.markdownRenderer.Render(source)
+	if err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	return html, nil
+}
+
+// withBodyHTML sets the rendered body of the article, cached per version of the article.
+func (as *APISvc) withBodyHTML(article *Article) (*Article, error) {
+	key := articleVersionKey{articleID: article.ID, version: article.Version}
+
+	if html, ok := as.renderedBodies.Get(key); ok {
+		article.BodyHTML = html
+
+		return article, nil
+	}
+
+	html, err := as.markdownRenderer.Render(article.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render article body: %w", err)
+	}
+
+	as.renderedBodies.Set(key, html)
+	article.BodyHTML = html
+
+	return article, nil
+}
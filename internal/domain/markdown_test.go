@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestMarkdownRenderer_Render(t *testing.T) {
+	t.Parallel()
+
+	renderer := NewMarkdownRenderer()
+
+	tests := []struct {
+		name       string
+		source     string
+		contains   []string
+		notContain []string
+	}{
+		{
+			name:     "commonmark",
+			source:   "# Title\n\nSome *emphasis* and a [link](https://example.com).",
+			contains: []string{`<h1 id="title">Title</h1>`, "<em>emphasis</em>", `<a href="https://example.com"`},
+		},
+		{
+			name:     "gfm table",
+			source:   "| a | b |\n|---|---|\n| 1 | 2 |",
+			contains: []string{"<table>", "<th>a</th>", "<td>2</td>"},
+		},
+		{
+			name:     "gfm task list",
+			source:   "- [x] done",
+			contains: []string{`<input checked="" disabled="" type="checkbox"`},
+		},
+		{
+			name:       "raw html is dropped",
+			source:     "<script>alert(1)</script>\n\n<img src=x onerror=alert(1)>",
+			notContain: []string{"<script", "onerror"},
+		},
+		{
+			name:       "javascript links are dropped",
+			source:     "[click](javascript:alert(1))",
+			notContain: []string{"javascript:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := renderer.Render(tt.source)
+			if err != nil {
+				t.Fatalf("MarkdownRenderer.Render() error = %v", err)
+			}
+
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("MarkdownRenderer.Render() = %q, want it to contain %q", got, want)
+				}
+			}
+
+			for _, unwanted := range tt.notContain {
+				if strings.Contains(got, unwanted) {
+					t.Errorf("MarkdownRenderer.Render() = %q, want it not to contain %q", got, unwanted)
+				}
+			}
+		})
+	}
+}
+
+func TestMarkdownRenderer_Metadata(t *testing.T) {
+	t.Parallel()
+
+	renderer := NewMarkdownRenderer()
+
+	tests := []struct {
+		name   string
+		source string
+		want   ArticleMetadata
+	}{
+		{
+			name:   "empty body",
+			source: "",
+			want:   ArticleMetadata{TableOfContents: []TOCEntry{}},
+		},
+		{
+			name:   "markup is not counted",
+			source: "# How to *train*\n\nSome **bold** words.\n\n## How to *train*\n\n- one\n- two",
+			want: ArticleMetadata{
+				WordCount:   11,
+				ReadingTime: 1,
+				TableOfContents: []TOCEntry{
+					{Level: 1, Text: "How to train", Anchor: "how-to-train"},
+					{Level: 2, Text: "How to train", Anchor: "how-to-train-1"},
+				},
+			},
+		},
+		{
+			name:   "reading time is rounded up",
+			source: strings.Repeat("word ", wordsPerMinute+1),
+			want: ArticleMetadata{
+				WordCount:       wordsPerMinute + 1,
+				ReadingTime:     2,
+				TableOfContents: []TOCEntry{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := renderer.Metadata(tt.source); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarkdownRenderer.Metadata() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPISvc_withBodyHTML(t *testing.T) {
+	t.Parallel()
+
+	svc := NewAPISvc(nil)
+
+	article := &Article{ID: uuid.Must(uuid.NewV7()), Version: 1, Body: "*one*"}
+
+	if _, err := svc.withBodyHTML(article); err != nil {
+		t.Fatalf("APISvc.withBodyHTML() error = %v", err)
+	}
+
+	if want := "<p><em>one</em></p>\n"; article.BodyHTML != want {
+		t.Errorf("APISvc.withBodyHTML() = %q, want %q", article.BodyHTML, want)
+	}
+
+	// the same version is served from the cache
+	cached := &Article{ID: article.ID, Version: 1, Body: "*ignored*"}
+
+	if _, err := svc.withBodyHTML(cached); err != nil {
+		t.Fatalf("APISvc.withBodyHTML() error = %v", err)
+	}
+
+	if cached.BodyHTML != article.BodyHTML {
+		t.Errorf("APISvc.withBodyHTML() = %q, want the cached %q", cached.BodyHTML, article.BodyHTML)
+	}
+
+	// a new version is rendered again
+	updated := &Article{ID: article.ID, Version: 2, Body: "*two*"}
+
+	if _, err := svc.withBodyHTML(updated); err != nil {
+		t.Fatalf("APISvc.withBodyHTML() error = %v", err)
+	}
+
+	if want := "<p><em>two</em></p>\n"; updated.BodyHTML != want {
+		t.Errorf("APISvc.withBodyHTML() = %q, want %q", updated.BodyHTML, want)
+	}
+}
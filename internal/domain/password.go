@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+var (
+	ErrInvalidCredentials    = fmt.Errorf("%w: invalid credentials", ErrUnauthorized)
+	ErrInvalidPasswordHash   = errors.New("invalid password hash")
+	ErrInvalidPasswordParams = errors.New("invalid password params")
+)
+
+// the weakest parameters accepted, salts and keys shorter than 128 bits are guessable
+const (
+	minPasswordSaltLength = 16
+	minPasswordKeyLength  = 16
+	// argon2 uses at least 8 KiB of memory per lane
+	minPasswordMemoryPerLane = 8
+)
+
+// PasswordParams are the argon2id parameters used to hash new passwords.
+type PasswordParams struct {
+	Memory      uint32 // in KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultPasswordParams follows the OWASP recommendation for argon2id.
+func DefaultPasswordParams() PasswordParams {
+	return PasswordParams{
+		Memory:      19 * 1024, //nolint:mnd // 19 MiB
+		Iterations:  2,         //nolint:mnd // owasp recommendation
+		Parallelism: 1,
+		SaltLength:  16, //nolint:mnd // 128 bits
+		KeyLength:   32, //nolint:mnd // 256 bits
+	}
+}
+
+// Validate rejects the parameters argon2id cannot hash with, or would hash weakly with.
+func (p PasswordParams) Validate() error {
+	switch {
+	case p.Iterations == 0:
+		return fmt.Errorf("%w: iterations must be at least 1", ErrInvalidPasswordParams)
+	case p.Parallelism == 0:
+		return fmt.Errorf("%w: parallelism must be at least 1", ErrInvalidPasswordParams)
+	case p.Memory < minPasswordMemoryPerLane*uint32(p.Parallelism):
+		return fmt.Errorf(
+			"%w: memory must be at least %d KiB per lane",
+			ErrInvalidPasswordParams,
+			minPasswordMemoryPerLane,
+		)
+	case p.SaltLength < minPasswordSaltLength:
+		return fmt.Errorf("%w: salt length must be at least %d", ErrInvalidPasswordParams, minPasswordSaltLength)
+	case p.KeyLength < minPasswordKeyLength:
+		return fmt.Errorf("%w: key length must be at least %d", ErrInvalidPasswordParams, minPasswordKeyLength)
+	default:
+		return nil
+	}
+}
+
+type PasswordHasher struct {
+	params PasswordParams
+
+	// verified instead of the hash of an unknown user, made once with the current parameters
+	dummyHash func() string
+}
+
+// NewPasswordHasher hashes with the params, which should be validated beforehand.
+func NewPasswordHasher(params PasswordParams) *PasswordHasher {
+	hasher := &PasswordHasher{
+		params: params,
+	}
+
+	hasher.dummyHash = sync.OnceValue(func() string {
+		hash, err := hasher.Hash(rand.Text())
+		if err != nil {
+			return argon2idPrefix
+		}
+
+		return hash
+	})
+
+	return hasher
+}
+
+// Hash returns the password hashed with argon2id, in the PHC string format.
+func (ph *PasswordHasher) Hash(password string) (string, error) {
+	salt := make([]byte, ph.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("could not generate salt: %w", err)
+	}
+
+	key := argon2.IDKey(
+		[]byte(password),
+		salt,
+		ph.params.Iterations,
+		ph.params.Memory,
+		ph.params.Parallelism,
+		ph.params.KeyLength,
+	)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		ph.params.Memory,
+		ph.params.Iterations,
+		ph.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify checks the password against the stored hash.
+// It also reports if the stored hash should be replaced, which is the case
+// for legacy plaintext passwords and for hashes made with weaker parameters.
+func (ph *PasswordHasher) Verify(password, encodedHash string) (bool, bool, error) {
+	// legacy rows store the raw password
+	if !strings.HasPrefix(encodedHash, argon2idPrefix) {
+		match := subtle.ConstantTimeCompare([]byte(password), []byte(encodedHash)) == 1
+
+		return match, match, nil
+	}
+
+	params, salt, key, err := decodeArgon2idHash(encodedHash)
+	if err != nil {
+		return false, false, err
+	}
+
+	//nolint:gosec // key length is bounded by the stored hash
+	otherKey := argon2.IDKey(
+		[]byte(password),
+		salt,
+		params.Iterations,
+		params.Memory,
+		params.Parallelism,
+		uint32(len(key)),
+	)
+
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return false, false, nil
+	}
+
+	return true, ph.isWeaker(params), nil
+}
+
+// VerifyDummy checks the password against a fixed hash that matches no password,
+// so that a login for an unknown user takes as long as a login with a wrong password.
+func (ph *PasswordHasher) VerifyDummy(password string) {
+	_, _, _ = ph.Verify(password, ph.dummyHash())
+}
+
+func (ph *PasswordHasher) isWeaker(params PasswordParams) bool {
+	return params.Memory < ph.params.Memory ||
+		params.Iterations < ph.params.Iterations ||
+		params.Parallelism < ph.params.Parallelism ||
+		params.SaltLength < ph.params.SaltLength ||
+		params.KeyLength < ph.params.KeyLength
+}
+
+func decodeArgon2idHash(encodedHash string) (PasswordParams, []byte, []byte, error) {
+	var params PasswordParams
+
+	// $argon2id$v=19$m=65536,t=3,p=2$salt$key
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 { //nolint:mnd // number of parts of the PHC format
+		return params, nil, nil, ErrInvalidPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, fmt.Errorf("%w: version: %w", ErrInvalidPasswordHash, err)
+	}
+
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf(
+			"%w: unsupported version %d",
+			ErrInvalidPasswordHash,
+			version,
+		)
+	}
+
+	if _, err := fmt.Sscanf(
+		parts[3],
+		"m=%d,t=%d,p=%d",
+		&params.Memory,
+		&params.Iterations,
+		&params.Parallelism,
+	); err != nil {
+		return params, nil, nil, fmt.Errorf("%w: params: %w", ErrInvalidPasswordHash, err)
+	}
+
+	salt, errS := base64.RawStdEncoding.DecodeString(parts[4])
+	if errS != nil {
+		return params, nil, nil, fmt.Errorf("%w: salt: %w", ErrInvalidPasswordHash, errS)
+	}
+
+	key, errK := base64.RawStdEncoding.DecodeString(parts[5])
+	if errK != nil {
+		return params, nil, nil, fmt.Errorf("%w: key: %w", ErrInvalidPasswordHash, errK)
+	}
+
+	//nolint:gosec // lengths are bounded by the stored hash
+	params.SaltLength, params.KeyLength = uint32(len(salt)), uint32(len(key))
+
+	return params, salt, key, nil
+}
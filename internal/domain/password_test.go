@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testPasswordParams() PasswordParams {
+	return PasswordParams{
+		Memory:      64,
+		Iterations:  2,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+func TestPasswordParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		modify  func(p *PasswordParams)
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(_ *PasswordParams) {},
+		},
+		{
+			name:    "no iterations",
+			modify:  func(p *PasswordParams) { p.Iterations = 0 },
+			wantErr: ErrInvalidPasswordParams,
+		},
+		{
+			name:    "no parallelism",
+			modify:  func(p *PasswordParams) { p.Parallelism = 0 },
+			wantErr: ErrInvalidPasswordParams,
+		},
+		{
+			name:    "too little memory for the lanes",
+			modify:  func(p *PasswordParams) { p.Parallelism = 16 },
+			wantErr: ErrInvalidPasswordParams,
+		},
+		{
+			name:    "no salt",
+			modify:  func(p *PasswordParams) { p.SaltLength = 0 },
+			wantErr: ErrInvalidPasswordParams,
+		},
+		{
+			name:    "empty key",
+			modify:  func(p *PasswordParams) { p.KeyLength = 0 },
+			wantErr: ErrInvalidPasswordParams,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := testPasswordParams()
+			tt.modify(&params)
+
+			if err := params.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("PasswordParams.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := DefaultPasswordParams().Validate(); err != nil {
+		t.Errorf("DefaultPasswordParams().Validate() error = %v", err)
+	}
+}
+
+func TestPasswordHasher_Hash(t *testing.T) {
+	t.Parallel()
+
+	hasher := NewPasswordHasher(testPasswordParams())
+
+	hash, err := hasher.Hash("123456")
+	if err != nil {
+		t.Fatalf("PasswordHasher.Hash() error = %v", err)
+	}
+
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=2,p=1$") {
+		t.Errorf("PasswordHasher.Hash() = %v, unexpected format", hash)
+	}
+
+	otherHash, errO := hasher.Hash("123456")
+	if errO != nil {
+		t.Fatalf("PasswordHasher.Hash() error = %v", errO)
+	}
+
+	if hash == otherHash {
+		t.Errorf("PasswordHasher.Hash() should use a random salt")
+	}
+}
+
+func TestPasswordHasher_Verify(t *testing.T) {
+	t.Parallel()
+
+	hasher := NewPasswordHasher(testPasswordParams())
+
+	hash, errH := hasher.Hash("123456")
+	if errH != nil {
+		t.Fatalf("PasswordHasher.Hash() error = %v", errH)
+	}
+
+	weakerParams := testPasswordParams()
+	weakerParams.Iterations = 1
+
+	weakerHash, errW := NewPasswordHasher(weakerParams).Hash("123456")
+	if errW != nil {
+		t.Fatalf("PasswordHasher.Hash() error = %v", errW)
+	}
+
+	tests := []struct {
+		name            string
+		password        string
+		encodedHash     string
+		wantMatch       bool
+		wantNeedsRehash bool
+		wantErr         error
+	}{
+		{
+			name:        "matching hash",
+			password:    "123456",
+			encodedHash: hash,
+			wantMatch:   true,
+		},
+		{
+			name:        "wrong password",
+			password:    "654321",
+			encodedHash: hash,
+		},
+		{
+			name:            "matching weaker hash",
+			password:        "123456",
+			encodedHash:     weakerHash,
+			wantMatch:       true,
+			wantNeedsRehash: true,
+		},
+		{
+			name:            "matching legacy plaintext",
+			password:        "123456",
+			encodedHash:     "123456",
+			wantMatch:       true,
+			wantNeedsRehash: true,
+		},
+		{
+			name:        "wrong legacy plaintext",
+			password:    "654321",
+			encodedHash: "123456",
+		},
+		{
+			name:        "malformed hash",
+			password:    "123456",
+			encodedHash: "$argon2id$v=19$m=64,t=2,p=1$salt",
+			wantErr:     ErrInvalidPasswordHash,
+		},
+		{
+			name:        "unsupported version",
+			password:    "123456",
+			encodedHash: "$argon2id$v=16$m=64,t=2,p=1$c2FsdA$a2V5",
+			wantErr:     ErrInvalidPasswordHash,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			match, needsRehash, err := hasher.Verify(tt.password, tt.encodedHash)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("PasswordHasher.Verify() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if match != tt.wantMatch || needsRehash != tt.wantNeedsRehash {
+				t.Errorf(
+					"PasswordHasher.Verify() = %v, %v, want %v, %v",
+					match,
+					needsRehash,
+					tt.wantMatch,
+					tt.wantNeedsRehash,
+				)
+			}
+		})
+	}
+}
+
+func TestPasswordHasher_VerifyDummy(t *testing.T) {
+	t.Parallel()
+
+	hasher := NewPasswordHasher(testPasswordParams())
+
+	dummy := hasher.dummyHash()
+	if !strings.HasPrefix(dummy, "$argon2id$v=19$m=64,t=2,p=1$") || hasher.dummyHash() != dummy {
+		t.Errorf("PasswordHasher.dummyHash() = %v, want a fixed hash with the current parameters", dummy)
+	}
+
+	if match, _, err := hasher.Verify("", dummy); match || err != nil {
+		t.Errorf("PasswordHasher.Verify() of the dummy hash = %v, %v, want no match", match, err)
+	}
+
+	hasher.VerifyDummy("123456")
+}
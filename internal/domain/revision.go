@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ArticleRevision is a version of the content of an article, numbered from 1 on its creation.
+// Editor is the user who wrote it, empty once that user is deleted.
+type ArticleRevision struct {
+	Number      int       `db:"number" json:"number"`
+	Title       string    `db:"title" json:"title"`
+	Description string    `db:"description" json:"description"`
+	Body        string    `db:"body" json:"body"`
+	Editor      *Profile  `db:"editor" json:"editor"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+}
+
+// ArticleRevisionDiff is the diff between two revisions of an article.
+type ArticleRevisionDiff struct {
+	From int
+	To   int
+	Diff string
+}
+
+const diffContextLines = 3
+
+// DiffArticleRevisions returns the line-level unified diff from a revision to another,
+// with one file per changed field as in git, so that it is empty for the same content.
+// A nil from revision is the empty article before its creation.
+func DiffArticleRevisions(from, to *ArticleRevision) (string, error) {
+	fromNumber := 0
+	if from == nil {
+		from = &ArticleRevision{}
+	} else {
+		fromNumber = from.Number
+	}
+
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{name: "title", from: from.Title, to: to.Title},
+		{name: "description", from: from.Description, to: to.Description},
+		{name: "body", from: from.Body, to: to.Body},
+	}
+
+	var diff strings.Builder
+
+	for _, field := range fields {
+		if field.from == field.to {
+			continue
+		}
+
+		if err := difflib.WriteUnifiedDiff(&diff, difflib.UnifiedDiff{
+			A:        splitDiffLines(field.from),
+			B:        splitDiffLines(field.to),
+			FromFile: "a/" + field.name,
+			FromDate: "revision " + strconv.Itoa(fromNumber),
+			ToFile:   "b/" + field.name,
+			ToDate:   "revision " + strconv.Itoa(to.Number),
+			Context:  diffContextLines,
+		}); err != nil {
+			return "", fmt.Errorf("could not diff %s: %w", field.name, err)
+		}
+	}
+
+	return diff.String(), nil
+}
+
+// splitDiffLines splits a text in lines ending with a newline, an empty text having none.
+func splitDiffLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return difflib.SplitLines(strings.TrimSuffix(text, "\n"))
+}
+
+//nolint:iface //for extension
+type ArticleRevisionService interface {
+	GetArticleRevisions(ctx context.Context, userID uuid.UUID, slug string) ([]*ArticleRevision, error)
+	GetArticleRevision(ctx context.Context, userID uuid.UUID, slug string, number int) (*ArticleRevision, error)
+	DiffArticleRevisions(
+		ctx context.Context,
+		userID uuid.UUID,
+		slug string,
+		from *int,
+		to int,
+	) (*ArticleRevisionDiff, error)
+	RestoreArticleRevision(
+		ctx context.Context,
+		userID uuid.UUID,
+		slug string,
+		number int,
+		expectedVersions []int,
+	) (*Article, error)
+}
+
+//nolint:iface //for extension
+type ArticleRevisionRepository interface {
+	GetArticleRevisions(ctx context.Context, userID uuid.UUID, slug string) ([]*ArticleRevision, error)
+	GetArticleRevision(ctx context.Context, userID uuid.UUID, slug string, number int) (*ArticleRevision, error)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import "testing"
+
+func TestDiffArticleRevisions(t *testing.T) {
+	t.Parallel()
+
+	first := &ArticleRevision{Number: 1, Title: "Dragons", Description: "How to", Body: "one\ntwo\nthree"}
+
+	tests := []struct {
+		name string
+		from *ArticleRevision
+		to   *ArticleRevision
+		want string
+	}{
+		{
+			name: "same content",
+			from: first,
+			to:   &ArticleRevision{Number: 2, Title: "Dragons", Description: "How to", Body: "one\ntwo\nthree\n"},
+			want: "",
+		},
+		{
+			name: "changed line",
+			from: first,
+			to:   &ArticleRevision{Number: 2, Title: "Dragons", Description: "How to", Body: "one\n2\nthree"},
+			want: "--- a/body\trevision 1\n+++ b/body\trevision 2\n@@ -1,3 +1,3 @@\n one\n-two\n+2\n three\n",
+		},
+		{
+			name: "from the empty article",
+			to:   &ArticleRevision{Number: 1, Title: "Dragons", Body: "one"},
+			want: "--- a/title\trevision 0\n+++ b/title\trevision 1\n@@ -0,0 +1 @@\n+Dragons\n" +
+				"--- a/body\trevision 0\n+++ b/body\trevision 1\n@@ -0,0 +1 @@\n+one\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DiffArticleRevisions(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("DiffArticleRevisions() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("DiffArticleRevisions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
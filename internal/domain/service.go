@@ -2,7 +2,12 @@ package domain
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/induzo/gocom/http/health"
@@ -12,15 +17,123 @@ import (
 var _ APIService = (*APISvc)(nil)
 
 type APISvc struct {
-	repository APIRepository
+	repository      APIRepository
+	passwordHasher  *PasswordHasher
+	refreshTokenTTL time.Duration
+
+	// signs the pagination cursors handed to the clients
+	cursorCodec *CursorCodec
+
+	// revocation state, cached to avoid a query on each authenticated request
+	revocationCacheTTL time.Duration
+	revokedTokens      *ttlCache[uuid.UUID, bool]
+	tokensRevokedAt    *ttlCache[uuid.UUID, *time.Time]
+
+	// renders the bodies of the articles, cached per version of the article
+	markdownRenderer *MarkdownRenderer
+	bodyHTMLCacheTTL time.Duration
+	renderedBodies   *ttlCache[articleVersionKey, string]
+
+	// how deep the replies to the comments can be nested
+	maxCommentDepth int
+	// how long after they are posted the comments can be edited
+	commentEditWindow time.Duration
+}
+
+type APISvcOption func(*APISvc)
+
+// WithPasswordHasher sets the hasher used for new and upgraded passwords.
+func WithPasswordHasher(hasher *PasswordHasher) APISvcOption {
+	return func(as *APISvc) {
+		as.passwordHasher = hasher
+	}
+}
+
+// WithRefreshTokenTTL sets the lifetime of issued refresh tokens.
+func WithRefreshTokenTTL(ttl time.Duration) APISvcOption {
+	return func(as *APISvc) {
+		as.refreshTokenTTL = ttl
+	}
+}
+
+// WithRevocationCacheTTL sets how long the revocation state of a token is cached.
+// A token revoked through another instance can still be used for up to this duration.
+func WithRevocationCacheTTL(ttl time.Duration) APISvcOption {
+	return func(as *APISvc) {
+		as.revocationCacheTTL = ttl
+	}
+}
+
+// WithBodyHTMLCacheTTL sets how long the rendered body of a version of an article is cached.
+func WithBodyHTMLCacheTTL(ttl time.Duration) APISvcOption {
+	return func(as *APISvc) {
+		as.bodyHTMLCacheTTL = ttl
+	}
+}
+
+// WithMaxCommentDepth sets how deep the replies can be nested, 0 disables the replies.
+func WithMaxCommentDepth(depth int) APISvcOption {
+	return func(as *APISvc) {
+		as.maxCommentDepth = depth
+	}
+}
+
+// WithCommentEditWindow sets how long after they are posted the comments can be edited,
+// 0 disables the edits.
+func WithCommentEditWindow(window time.Duration) APISvcOption {
+	return func(as *APISvc) {
+		as.commentEditWindow = window
+	}
 }
 
-func NewAPISvc(repo APIRepository) *APISvc {
-	return &APISvc{
-		repository: repo,
+// WithCursorSecret sets the key signing the pagination cursors.
+// It must be shared by all the instances serving the api, an empty secret is ignored.
+func WithCursorSecret(secret []byte) APISvcOption {
+	return func(as *APISvc) {
+		if len(secret) == 0 {
+			return
+		}
+
+		as.cursorCodec = NewCursorCodec(secret)
 	}
 }
 
+const (
+	defaultRefreshTokenTTL    = 30 * 24 * time.Hour
+	defaultRevocationCacheTTL = 30 * time.Second
+	defaultBodyHTMLCacheTTL   = time.Hour
+	defaultMaxCommentDepth    = 5
+	defaultCommentEditWindow  = 15 * time.Minute
+)
+
+func NewAPISvc(repo APIRepository, opts ...APISvcOption) *APISvc {
+	svc := &APISvc{
+		repository:         repo,
+		passwordHasher:     NewPasswordHasher(DefaultPasswordParams()),
+		refreshTokenTTL:    defaultRefreshTokenTTL,
+		revocationCacheTTL: defaultRevocationCacheTTL,
+		markdownRenderer:   NewMarkdownRenderer(),
+		bodyHTMLCacheTTL:   defaultBodyHTMLCacheTTL,
+		maxCommentDepth:    defaultMaxCommentDepth,
+		commentEditWindow:  defaultCommentEditWindow,
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	// without a shared secret, cursors are only valid on this instance until it restarts
+	if svc.cursorCodec == nil {
+		svc.cursorCodec = NewCursorCodec([]byte(rand.Text()))
+	}
+
+	svc.revokedTokens = newTTLCache[uuid.UUID, bool](svc.revocationCacheTTL)
+	svc.tokensRevokedAt = newTTLCache[uuid.UUID, *time.Time](svc.revocationCacheTTL)
+	svc.renderedBodies = newTTLCache[articleVersionKey, string](svc.bodyHTMLCacheTTL)
+
+	return svc
+}
+
 func (as *APISvc) GetTags(ctx context.Context) ([]Tag, error) {
 	tags, err := as.repository.GetTags(ctx)
 	if err != nil {
@@ -33,46 +146,137 @@ func (as *APISvc) GetTags(ctx context.Context) ([]Tag, error) {
 func (as *APISvc) GetArticles(
 	ctx context.Context,
 	userID uuid.UUID,
-	author, tag, favorited *string,
+	filter ArticleFilter,
 	limit, offset *int,
-) ([]*Article, error) {
-	articles, err := as.repository.GetArticles(ctx, userID, author, tag, favorited, limit, offset)
+	cursor *string,
+) (*ArticlePage, error) {
+	after, errC := as.articleListingCursor(filter, cursor)
+	if errC != nil {
+		return nil, fmt.Errorf("failed to get articles: %w", errC)
+	}
+
+	page, err := as.repository.GetArticles(ctx, userID, filter, limit, offset, after)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get articles: %w", err)
 	}
 
-	return articles, nil
+	if err := as.setNextArticleCursor(page, filter.Sort, limit); err != nil {
+		return nil, err
+	}
+
+	return page, nil
 }
 
 func (as *APISvc) GetArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error) {
 	article, err := as.repository.GetArticle(ctx, userID, slug)
+	if errors.Is(err, ErrNotFound) {
+		// the slug may be a former one of a renamed article
+		currentSlug, errS := as.repository.GetArticleCurrentSlug(ctx, userID, slug)
+		if errS == nil && currentSlug != slug {
+			return nil, &ArticleMovedError{Slug: currentSlug}
+		}
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to get article: %w", err)
 	}
 
-	return article, nil
+	return as.withBodyHTML(article)
+}
+
+func (as *APISvc) SearchArticles(
+	ctx context.Context,
+	userID uuid.UUID,
+	query string,
+	limit, offset *int,
+) (*ArticleSearchPage, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf(
+			"failed to search articles: %w",
+			NewFieldError("q", "is required", ErrInvalidSearchQuery),
+		)
+	}
+
+	page, err := as.repository.SearchArticles(ctx, userID, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search articles: %w", err)
+	}
+
+	return page, nil
 }
 
 func (as *APISvc) GetFeedArticles(
 	ctx context.Context,
 	userID uuid.UUID,
-	username, tag, favorited *string,
+	filter ArticleFilter,
 	limit, offset *int,
-) ([]*Article, error) {
-	articles, err := as.repository.GetFeedArticles(
-		ctx,
-		userID,
-		username,
-		tag,
-		favorited,
-		limit,
-		offset,
-	)
+	cursor *string,
+) (*ArticlePage, error) {
+	after, errC := as.articleListingCursor(filter, cursor)
+	if errC != nil {
+		return nil, fmt.Errorf("failed to get feed articles: %w", errC)
+	}
+
+	page, err := as.repository.GetFeedArticles(ctx, userID, filter, limit, offset, after)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get feed articles: %w", err)
 	}
 
-	return articles, nil
+	if err := as.setNextArticleCursor(page, filter.Sort, limit); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// articleListingCursor validates the filter of a listing and decodes its cursor.
+// The counts sorts are not keyset, they can only be paged with an offset.
+func (as *APISvc) articleListingCursor(filter ArticleFilter, cursor *string) (*Cursor, error) {
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
+	if cursor != nil && !filter.Sort.Keyset() {
+		return nil, NewFieldError(
+			"cursor",
+			"is not supported when sorting by "+string(filter.Sort),
+			ErrInvalidArticleFilter,
+		)
+	}
+
+	return as.decodeCursor(cursor)
+}
+
+// decodeCursor verifies the cursor sent by a client, if any.
+func (as *APISvc) decodeCursor(cursor *string) (*Cursor, error) {
+	if cursor == nil {
+		return nil, nil //nolint:nilnil // no cursor, first page
+	}
+
+	after, err := as.cursorCodec.Decode(*cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", NewFieldError("cursor", "is invalid", err))
+	}
+
+	return after, nil
+}
+
+// setNextArticleCursor points to the last article when the page is full,
+// a short page is the last one.
+func (as *APISvc) setNextArticleCursor(page *ArticlePage, sort ArticleSort, limit *int) error {
+	if !sort.Keyset() || limit == nil || len(page.Articles) == 0 || len(page.Articles) < *limit {
+		return nil
+	}
+
+	next, err := as.cursorCodec.Encode(page.Articles[len(page.Articles)-1].Cursor())
+	if err != nil {
+		return fmt.Errorf("failed to encode cursor: %w", err)
+	}
+
+	page.NextCursor = next
+
+	return nil
 }
 
 func (as *APISvc) CreateArticle(
@@ -80,30 +284,113 @@ func (as *APISvc) CreateArticle(
 	userID uuid.UUID,
 	title, description, body string,
 	tagList []string,
+	draft bool,
+	publishAt *time.Time,
 ) (*Article, error) {
-	article, err := as.repository.CreateArticle(ctx, userID, title, description, body, tagList)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create article: %w", err)
+	status, errS := NewArticleStatus(draft, publishAt, time.Now())
+	if errS != nil {
+		return nil, fmt.Errorf("failed to create article: %w", errS)
 	}
 
-	return article, nil
+	metadata := as.markdownRenderer.Metadata(body)
+
+	for attempt := range maxSlugAttempts {
+		article, err := as.repository.CreateArticle(
+			ctx,
+			userID,
+			SlugCandidate(title, attempt),
+			title,
+			description,
+			body,
+			tagList,
+			status,
+			publishAt,
+			metadata,
+		)
+		if errors.Is(err, ErrSlugTaken) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create article: %w", err)
+		}
+
+		return as.withBodyHTML(article)
+	}
+
+	return nil, fmt.Errorf("failed to create article: %w", ErrSlugTaken)
 }
 
+// UpdateArticle updates the article, failing with ErrVersionMismatch
+// when its version is not one of the expected ones.
 func (as *APISvc) UpdateArticle(
 	ctx context.Context,
 	userID uuid.UUID,
 	slug string,
 	title, description, body *string,
+	tagList *[]string,
+	expectedVersions []int,
 ) (*Article, error) {
-	article, err := as.repository.UpdateArticle(ctx, userID, slug, title, description, body)
-	if err != nil {
+	if err := as.authorizeArticleEdit(ctx, userID, slug); err != nil {
 		return nil, fmt.Errorf("failed to update article: %w", err)
 	}
 
-	return article, nil
+	// the metadata is computed from the body
+	var metadata *ArticleMetadata
+
+	if body != nil {
+		bodyMetadata := as.markdownRenderer.Metadata(*body)
+		metadata = &bodyMetadata
+	}
+
+	keepSlug := title == nil
+
+	if !keepSlug {
+		current, err := as.repository.GetArticle(ctx, userID, slug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update article: %w", err)
+		}
+
+		keepSlug = TitleKeepsSlug(current.Title, *title)
+	}
+
+	// a renamed article gets a new slug, the former one redirects to it
+	if keepSlug {
+		article, err := as.repository.UpdateArticle(
+			ctx, userID, slug, nil, title, description, body, tagList, metadata, expectedVersions,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update article: %w", err)
+		}
+
+		return as.withBodyHTML(article)
+	}
+
+	for attempt := range maxSlugAttempts {
+		newSlug := SlugCandidate(*title, attempt)
+
+		article, err := as.repository.UpdateArticle(
+			ctx, userID, slug, &newSlug, title, description, body, tagList, metadata, expectedVersions,
+		)
+		if errors.Is(err, ErrSlugTaken) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to update article: %w", err)
+		}
+
+		return as.withBodyHTML(article)
+	}
+
+	return nil, fmt.Errorf("failed to update article: %w", ErrSlugTaken)
 }
 
 func (as *APISvc) DeleteArticle(ctx context.Context, userID uuid.UUID, slug string) error {
+	if err := as.authorizeArticleEdit(ctx, userID, slug); err != nil {
+		return fmt.Errorf("failed to delete article: %w", err)
+	}
+
 	if err := as.repository.DeleteArticle(ctx, userID, slug); err != nil {
 		return fmt.Errorf("failed to delete article: %w", err)
 	}
@@ -111,6 +398,192 @@ func (as *APISvc) DeleteArticle(ctx context.Context, userID uuid.UUID, slug stri
 	return nil
 }
 
+// GetDraftArticles lists the articles of the user that are not published yet.
+func (as *APISvc) GetDraftArticles(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset *int,
+) (*ArticlePage, error) {
+	page, err := as.repository.GetDraftArticles(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft articles: %w", err)
+	}
+
+	return page, nil
+}
+
+// GetTrashedArticles lists the articles the user deleted, which are kept until they are purged.
+func (as *APISvc) GetTrashedArticles(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset *int,
+) (*ArticlePage, error) {
+	page, err := as.repository.GetTrashedArticles(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trashed articles: %w", err)
+	}
+
+	return page, nil
+}
+
+// RestoreArticle takes the article out of the trash of the user.
+func (as *APISvc) RestoreArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error) {
+	article, err := as.repository.RestoreArticle(ctx, userID, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore article: %w", err)
+	}
+
+	return as.withBodyHTML(article)
+}
+
+// PublishArticle publishes the article now, or schedules it when publishAt is set.
+func (as *APISvc) PublishArticle(
+	ctx context.Context,
+	userID uuid.UUID,
+	slug string,
+	publishAt *time.Time,
+) (*Article, error) {
+	if publishAt != nil {
+		if err := validatePublishAt(*publishAt, time.Now()); err != nil {
+			return nil, fmt.Errorf("failed to publish article: %w", err)
+		}
+	}
+
+	if err := as.authorizeArticleEdit(ctx, userID, slug); err != nil {
+		return nil, fmt.Errorf("failed to publish article: %w", err)
+	}
+
+	article, err := as.repository.PublishArticle(ctx, userID, slug, publishAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish article: %w", err)
+	}
+
+	return as.withBodyHTML(article)
+}
+
+// UnpublishArticle turns the article back into a draft, cancelling its scheduled publication.
+func (as *APISvc) UnpublishArticle(ctx context.Context, userID uuid.UUID, slug string) (*Article, error) {
+	if err := as.authorizeArticleEdit(ctx, userID, slug); err != nil {
+		return nil, fmt.Errorf("failed to unpublish article: %w", err)
+	}
+
+	article, err := as.repository.UnpublishArticle(ctx, userID, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpublish article: %w", err)
+	}
+
+	return as.withBodyHTML(article)
+}
+
+func (as *APISvc) GetArticleRevisions(
+	ctx context.Context,
+	userID uuid.UUID,
+	slug string,
+) ([]*ArticleRevision, error) {
+	revisions, err := as.repository.GetArticleRevisions(ctx, userID, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get article revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+func (as *APISvc) GetArticleRevision(
+	ctx context.Context,
+	userID uuid.UUID,
+	slug string,
+	number int,
+) (*ArticleRevision, error) {
+	revision, err := as.repository.GetArticleRevision(ctx, userID, slug, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get article revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+// DiffArticleRevisions diffs a revision against an earlier one, by default the previous one.
+// Revision 0 is the empty article before its creation.
+func (as *APISvc) DiffArticleRevisions(
+	ctx context.Context,
+	userID uuid.UUID,
+	slug string,
+	from *int,
+	to int,
+) (*ArticleRevisionDiff, error) {
+	fromNumber := to - 1
+	if from != nil {
+		fromNumber = *from
+	}
+
+	toRevision, errT := as.repository.GetArticleRevision(ctx, userID, slug, to)
+	if errT != nil {
+		return nil, fmt.Errorf("failed to get article revision: %w", errT)
+	}
+
+	var fromRevision *ArticleRevision
+
+	if fromNumber > 0 {
+		revision, errF := as.repository.GetArticleRevision(ctx, userID, slug, fromNumber)
+		if errF != nil {
+			return nil, fmt.Errorf("failed to get article revision: %w", errF)
+		}
+
+		fromRevision = revision
+	}
+
+	diff, err := DiffArticleRevisions(fromRevision, toRevision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff article revisions: %w", err)
+	}
+
+	return &ArticleRevisionDiff{
+		From: fromNumber,
+		To:   to,
+		Diff: diff,
+	}, nil
+}
+
+// RestoreArticleRevision updates the article with the content of the revision,
+// which makes a new revision.
+func (as *APISvc) RestoreArticleRevision(
+	ctx context.Context,
+	userID uuid.UUID,
+	slug string,
+	number int,
+	expectedVersions []int,
+) (*Article, error) {
+	revision, errR := as.repository.GetArticleRevision(ctx, userID, slug, number)
+	if errR != nil {
+		return nil, fmt.Errorf("failed to get article revision: %w", errR)
+	}
+
+	article, err := as.UpdateArticle(
+		ctx,
+		userID,
+		slug,
+		&revision.Title,
+		&revision.Description,
+		&revision.Body,
+		nil,
+		expectedVersions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore article revision: %w", err)
+	}
+
+	return article, nil
+}
+
+func (as *APISvc) authorizeArticleEdit(ctx context.Context, userID uuid.UUID, slug string) error {
+	authorID, err := as.repository.GetArticleAuthorID(ctx, slug)
+	if err != nil {
+		return fmt.Errorf("failed to get article author: %w", err)
+	}
+
+	return CanEditArticle(userID, authorID)
+}
+
 func (as *APISvc) FavoriteArticle(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -121,7 +594,7 @@ func (as *APISvc) FavoriteArticle(
 		return nil, fmt.Errorf("failed to favorite article: %w", err)
 	}
 
-	return article, nil
+	return as.withBodyHTML(article)
 }
 
 func (as *APISvc) UnfavoriteArticle(
@@ -134,7 +607,7 @@ func (as *APISvc) UnfavoriteArticle(
 		return nil, fmt.Errorf("failed to unfavorite article: %w", err)
 	}
 
-	return article, nil
+	return as.withBodyHTML(article)
 }
 
 func (as *APISvc) RegisterUser(
@@ -142,7 +615,12 @@ func (as *APISvc) RegisterUser(
 	userID uuid.UUID,
 	email, username, password string,
 ) (*User, error) {
-	user, err := as.repository.RegisterUser(ctx, userID, email, username, password)
+	hash, errH := as.passwordHasher.Hash(password)
+	if errH != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", errH)
+	}
+
+	user, err := as.repository.RegisterUser(ctx, userID, email, username, hash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register user: %w", err)
 	}
@@ -150,13 +628,56 @@ func (as *APISvc) RegisterUser(
 	return user, nil
 }
 
-func (as *APISvc) AuthUser(ctx context.Context, email, password string) (*User, string, error) {
-	user, token, err := as.repository.AuthUser(ctx, email, password)
+// AuthUser verifies the credentials of a user, and upgrades the stored
+// password hash if it is a legacy or a weaker one.
+func (as *APISvc) AuthUser(ctx context.Context, email, password string) (*User, error) {
+	user, err := as.repository.GetUserByEmail(ctx, email)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to authenticate user: %w", err)
+		// an unknown email is reported as wrong credentials, and takes as long to verify,
+		// not to disclose the registered ones
+		if errors.Is(err, ErrNotFound) {
+			as.passwordHasher.VerifyDummy(password)
+
+			return nil, fmt.Errorf("failed to authenticate user: %w", ErrInvalidCredentials)
+		}
+
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	match, needsRehash, errV := as.passwordHasher.Verify(password, user.Password)
+	if errV != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", errV)
 	}
 
-	return user, token, nil
+	if !match {
+		return nil, fmt.Errorf("failed to authenticate user: %w", ErrInvalidCredentials)
+	}
+
+	if needsRehash {
+		user = as.upgradePasswordHash(ctx, user, password)
+	}
+
+	return user, nil
+}
+
+// upgradePasswordHash replaces a legacy or weaker hash of the password of the user.
+// The login succeeded already, a failed upgrade is only logged and retried on the next login.
+func (as *APISvc) upgradePasswordHash(ctx context.Context, user *User, password string) *User {
+	hash, err := as.passwordHasher.Hash(password)
+	if err != nil {
+		slog.ErrorContext(ctx, "could not rehash password", slog.Any("user_id", user.ID), slog.Any("err", err))
+
+		return user
+	}
+
+	upgraded, err := as.repository.UpdateUser(ctx, user.ID, nil, nil, &hash, nil, nil, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "could not upgrade password hash", slog.Any("user_id", user.ID), slog.Any("err", err))
+
+		return user
+	}
+
+	return upgraded
 }
 
 func (as *APISvc) GetUser(ctx context.Context, username string) (*User, error) {
@@ -177,19 +698,214 @@ func (as *APISvc) GetCurrentUser(ctx context.Context, userID uuid.UUID) (*User,
 	return user, nil
 }
 
+// UpdateUser updates the user, failing with ErrVersionMismatch
+// when its version is not one of the expected ones.
 func (as *APISvc) UpdateUser(
 	ctx context.Context,
 	userID uuid.UUID,
 	username, email, password, bio, image *string,
+	expectedVersions []int,
 ) (*User, error) {
-	user, err := as.repository.UpdateUser(ctx, userID, username, email, password, bio, image)
+	if password != nil {
+		hash, errH := as.passwordHasher.Hash(*password)
+		if errH != nil {
+			return nil, fmt.Errorf("failed to hash password: %w", errH)
+		}
+
+		password = &hash
+	}
+
+	user, err := as.repository.UpdateUser(ctx, userID, username, email, password, bio, image, expectedVersions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
+	// a new password logs the user out everywhere
+	if password != nil {
+		if err := as.RevokeAllSessions(ctx, userID); err != nil {
+			return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
+
 	return user, nil
 }
 
+// CreateRefreshToken issues a refresh token starting a new token family.
+func (as *APISvc) CreateRefreshToken(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*IssuedRefreshToken, error) {
+	token, refreshToken, errN := newRefreshToken(userID, uuid.Nil, as.refreshTokenTTL)
+	if errN != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", errN)
+	}
+
+	if err := as.repository.CreateRefreshToken(ctx, refreshToken); err != nil {
+		return nil, fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	return &IssuedRefreshToken{
+		Token:    token,
+		FamilyID: refreshToken.FamilyID,
+	}, nil
+}
+
+// RotateRefreshToken exchanges a refresh token for a new one of the same family.
+// Presenting an already rotated token revokes the whole family, as it means
+// the token has been stolen.
+func (as *APISvc) RotateRefreshToken(
+	ctx context.Context,
+	token string,
+) (*User, *IssuedRefreshToken, error) {
+	current, err := as.repository.GetRefreshToken(ctx, HashToken(token))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil, fmt.Errorf("failed to get refresh token: %w", ErrInvalidRefreshToken)
+		}
+
+		return nil, nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	if current.RevokedAt != nil {
+		return nil, nil, as.revokeRefreshTokenFamily(ctx, current.FamilyID)
+	}
+
+	if !time.Now().Before(current.ExpiresAt) {
+		return nil, nil, fmt.Errorf("failed to rotate refresh token: %w", ErrInvalidRefreshToken)
+	}
+
+	nextToken, next, errN := newRefreshToken(current.UserID, current.FamilyID, as.refreshTokenTTL)
+	if errN != nil {
+		return nil, nil, fmt.Errorf("failed to generate refresh token: %w", errN)
+	}
+
+	if err := as.repository.RotateRefreshToken(ctx, current.ID, next); err != nil {
+		// lost the race against another use of the same token
+		if errors.Is(err, ErrRefreshTokenReused) {
+			return nil, nil, as.revokeRefreshTokenFamily(ctx, current.FamilyID)
+		}
+
+		return nil, nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	user, errU := as.repository.GetCurrentUser(ctx, current.UserID)
+	if errU != nil {
+		return nil, nil, fmt.Errorf("failed to get refresh token user: %w", errU)
+	}
+
+	return user, &IssuedRefreshToken{
+		Token:    nextToken,
+		FamilyID: next.FamilyID,
+	}, nil
+}
+
+func (as *APISvc) revokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	if err := as.repository.RevokeRefreshTokenFamily(ctx, familyID); err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return fmt.Errorf("failed to rotate refresh token: %w", ErrRefreshTokenReused)
+}
+
+func (as *APISvc) RevokeSession(
+	ctx context.Context,
+	userID, tokenID uuid.UUID,
+	expiresAt time.Time,
+	sessionID uuid.UUID,
+) error {
+	if err := as.repository.RevokeToken(ctx, userID, tokenID, expiresAt); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	as.revokedTokens.Set(tokenID, true)
+
+	if sessionID != uuid.Nil {
+		if err := as.repository.RevokeRefreshTokenFamily(ctx, sessionID); err != nil {
+			return fmt.Errorf("failed to revoke refresh token family: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (as *APISvc) RevokeAllSessions(ctx context.Context, userID uuid.UUID) error {
+	revokedAt, err := as.repository.RevokeAllTokens(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke all tokens: %w", err)
+	}
+
+	as.tokensRevokedAt.Set(userID, &revokedAt)
+
+	return nil
+}
+
+// IsTokenRevoked checks the revocation list, only asking the repository
+// when the state of the token or of its user is not cached.
+func (as *APISvc) IsTokenRevoked(
+	ctx context.Context,
+	userID, tokenID uuid.UUID,
+	issuedAt time.Time,
+) (bool, error) {
+	revoked, okToken := as.revokedTokens.Get(tokenID)
+	revokedBefore, okUser := as.tokensRevokedAt.Get(userID)
+
+	if !okToken || !okUser {
+		revocation, err := as.repository.GetTokenRevocation(ctx, userID, tokenID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get token revocation: %w", err)
+		}
+
+		revoked, revokedBefore = revocation.Revoked, revocation.RevokedBefore
+
+		as.revokedTokens.Set(tokenID, revoked)
+		as.tokensRevokedAt.Set(userID, revokedBefore)
+	}
+
+	if revokedBefore != nil && issuedAt.Before(*revokedBefore) {
+		return true, nil
+	}
+
+	return revoked, nil
+}
+
+func (as *APISvc) CreatePersonalAccessToken(ctx context.Context, token *PersonalAccessToken) error {
+	if err := token.validate(); err != nil {
+		return fmt.Errorf("failed to validate personal access token: %w", err)
+	}
+
+	if token.TokenHash == "" {
+		return fmt.Errorf("failed to validate personal access token: %w", ErrInvalidPersonalAccessToken)
+	}
+
+	if err := as.repository.CreatePersonalAccessToken(ctx, token); err != nil {
+		return fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	return nil
+}
+
+func (as *APISvc) GetPersonalAccessTokens(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*PersonalAccessToken, error) {
+	tokens, err := as.repository.GetPersonalAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal access tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (as *APISvc) RevokePersonalAccessToken(ctx context.Context, userID, tokenID uuid.UUID) error {
+	if err := as.repository.RevokePersonalAccessToken(ctx, userID, tokenID); err != nil {
+		return fmt.Errorf("failed to revoke personal access token: %w", err)
+	}
+
+	as.revokedTokens.Set(tokenID, true)
+
+	return nil
+}
+
 func (as *APISvc) GetProfile(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -229,25 +945,135 @@ func (as *APISvc) UnfollowUser(
 	return profile, nil
 }
 
+// GetFollowers lists the users following the user of the username, latest first.
+func (as *APISvc) GetFollowers(
+	ctx context.Context,
+	userID uuid.UUID,
+	username string,
+	limit *int,
+	cursor *string,
+) (*FollowPage, error) {
+	after, errC := as.decodeCursor(cursor)
+	if errC != nil {
+		return nil, errC
+	}
+
+	follows, err := as.repository.GetFollowers(ctx, userID, username, limit, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get followers: %w", err)
+	}
+
+	return as.followPage(follows, limit)
+}
+
+// GetFollowing lists the users the user of the username follows, latest first.
+func (as *APISvc) GetFollowing(
+	ctx context.Context,
+	userID uuid.UUID,
+	username string,
+	limit *int,
+	cursor *string,
+) (*FollowPage, error) {
+	after, errC := as.decodeCursor(cursor)
+	if errC != nil {
+		return nil, errC
+	}
+
+	follows, err := as.repository.GetFollowing(ctx, userID, username, limit, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following: %w", err)
+	}
+
+	return as.followPage(follows, limit)
+}
+
+// followPage points to the last follow when the page is full, a short page is the last one.
+func (as *APISvc) followPage(follows []*Follow, limit *int) (*FollowPage, error) {
+	page := &FollowPage{Follows: follows}
+
+	if limit != nil && len(follows) > 0 && len(follows) >= *limit {
+		next, err := as.cursorCodec.Encode(follows[len(follows)-1].Cursor())
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode cursor: %w", err)
+		}
+
+		page.NextCursor = next
+	}
+
+	return page, nil
+}
+
 func (as *APISvc) GetComments(
 	ctx context.Context,
 	userID uuid.UUID,
 	slug string,
-) ([]*Comment, error) {
-	comments, err := as.repository.GetComments(ctx, userID, slug)
+	sort CommentSort,
+	limit, offset *int,
+	cursor *string,
+) (*CommentPage, error) {
+	if err := sort.validate(); err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	if cursor != nil && !sort.Keyset() {
+		return nil, fmt.Errorf("failed to get comments: %w", NewFieldError(
+			"cursor",
+			"is not supported when sorting by "+string(sort),
+			ErrInvalidCommentSort,
+		))
+	}
+
+	after, errC := as.decodeCursor(cursor)
+	if errC != nil {
+		return nil, errC
+	}
+
+	comments, err := as.repository.GetComments(ctx, userID, slug, sort, limit, offset, after)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get comments: %w", err)
 	}
 
-	return comments, nil
+	page := &CommentPage{Comments: comments}
+
+	// the limit counts the threads, a short page is the last one
+	var threads []*Comment
+
+	for _, comment := range comments {
+		if comment.ParentID == nil {
+			threads = append(threads, comment)
+		}
+	}
+
+	if sort.Keyset() && limit != nil && len(threads) > 0 && len(threads) >= *limit {
+		next, errE := as.cursorCodec.Encode(threads[len(threads)-1].Cursor())
+		if errE != nil {
+			return nil, fmt.Errorf("failed to encode cursor: %w", errE)
+		}
+
+		page.NextCursor = next
+	}
+
+	return page, nil
 }
 
 func (as *APISvc) AddComment(
 	ctx context.Context,
 	authorID uuid.UUID,
 	slug, body string,
+	parentID *int,
 ) (*Comment, error) {
-	comment, err := as.repository.AddComment(ctx, authorID, slug, body)
+	if parentID != nil {
+		parentDepth, errD := as.repository.GetCommentDepth(ctx, slug, *parentID)
+		if errD != nil {
+			return nil, fmt.Errorf("failed to get parent comment: %w", errD)
+		}
+
+		if err := CanReplyToComment(parentDepth, as.maxCommentDepth); err != nil {
+			return nil, fmt.Errorf("failed to add comment: %w", err)
+		}
+	}
+
+	comment, err := as.repository.AddComment(ctx, authorID, slug, body, parentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add comment: %w", err)
 	}
@@ -255,7 +1081,44 @@ func (as *APISvc) AddComment(
 	return comment, nil
 }
 
-func (as *APISvc) DeleteComment(ctx context.Context, slug string, id int) error {
+// UpdateComment updates the body of the comment if the user is allowed to by CanEditComment,
+// the former body is kept as a revision.
+func (as *APISvc) UpdateComment(
+	ctx context.Context,
+	userID uuid.UUID,
+	slug string,
+	id int,
+	body string,
+) (*Comment, error) {
+	authorship, errA := as.repository.GetCommentAuthorship(ctx, slug, id)
+	if errA != nil {
+		return nil, fmt.Errorf("failed to get comment authorship: %w", errA)
+	}
+
+	if err := CanEditComment(userID, authorship, as.commentEditWindow, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	comment, err := as.repository.UpdateComment(ctx, slug, id, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// DeleteComment deletes the comment if the user is allowed to by CanDeleteComment,
+// a comment with replies is replaced by a placeholder.
+func (as *APISvc) DeleteComment(ctx context.Context, userID uuid.UUID, slug string, id int) error {
+	authorship, errA := as.repository.GetCommentAuthorship(ctx, slug, id)
+	if errA != nil {
+		return fmt.Errorf("failed to get comment authorship: %w", errA)
+	}
+
+	if err := CanDeleteComment(userID, authorship); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
 	if err := as.repository.DeleteComment(ctx, slug, id); err != nil {
 		return fmt.Errorf("failed to delete comment: %w", err)
 	}
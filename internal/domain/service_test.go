@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// stubUserRepository holds a single user, the methods it does not override
+// panic on the nil embedded repository.
+type stubUserRepository struct {
+	APIRepository
+
+	user      *User
+	updateErr error
+}
+
+func (r stubUserRepository) GetUserByEmail(_ context.Context, email string) (*User, error) {
+	if r.user == nil || r.user.Email != email {
+		return nil, fmt.Errorf("stub: %w", ErrNotFound)
+	}
+
+	user := *r.user
+
+	return &user, nil
+}
+
+func (r stubUserRepository) UpdateUser(
+	_ context.Context,
+	_ uuid.UUID,
+	_, _, password, _, _ *string,
+	_ []int,
+) (*User, error) {
+	if r.updateErr != nil {
+		return nil, r.updateErr
+	}
+
+	upgraded := *r.user
+	upgraded.Password = *password
+
+	return &upgraded, nil
+}
+
+func TestAPISvc_AuthUser(t *testing.T) {
+	t.Parallel()
+
+	legacy := &User{ID: uuid.Must(uuid.NewV7()), Email: "jake@jake.jake", Password: "jakejake"}
+
+	tests := []struct {
+		name         string
+		repo         stubUserRepository
+		email        string
+		password     string
+		wantErr      error
+		wantUpgraded bool
+	}{
+		{
+			name:         "legacy password is upgraded",
+			repo:         stubUserRepository{user: legacy},
+			email:        legacy.Email,
+			password:     "jakejake",
+			wantUpgraded: true,
+		},
+		{
+			name:         "failed upgrade still logs in",
+			repo:         stubUserRepository{user: legacy, updateErr: errors.New("connection reset")},
+			email:        legacy.Email,
+			password:     "jakejake",
+			wantUpgraded: false,
+		},
+		{
+			name:     "wrong password",
+			repo:     stubUserRepository{user: legacy},
+			email:    legacy.Email,
+			password: "jake",
+			wantErr:  ErrInvalidCredentials,
+		},
+		{
+			name:     "unknown email",
+			repo:     stubUserRepository{user: legacy},
+			email:    "finn@jake.jake",
+			password: "jakejake",
+			wantErr:  ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewAPISvc(tt.repo, WithPasswordHasher(NewPasswordHasher(testPasswordParams())))
+
+			user, err := svc.AuthUser(t.Context(), tt.email, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("APISvc.AuthUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if upgraded := user.Password != legacy.Password; upgraded != tt.wantUpgraded {
+				t.Errorf("APISvc.AuthUser() upgraded = %v, want %v", upgraded, tt.wantUpgraded)
+			}
+		})
+	}
+}
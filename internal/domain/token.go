@@ -0,0 +1,244 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	refreshTokenBytes = 32
+	// personal access tokens expire after a year when no expiry is requested
+	defaultPersonalAccessTokenExpiry = 365 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidRefreshToken         = fmt.Errorf("%w: invalid refresh token", ErrUnauthorized)
+	ErrRefreshTokenReused          = fmt.Errorf("%w: refresh token reused", ErrUnauthorized)
+	ErrInvalidScope                = fmt.Errorf("%w: invalid scope", ErrValidation)
+	ErrInvalidPersonalAccessToken  = fmt.Errorf("%w: invalid personal access token", ErrValidation)
+	ErrPersonalAccessTokenNotFound = fmt.Errorf("%w: personal access token", ErrNotFound)
+)
+
+// Scopes are carried as private claims of the access tokens, and required
+// by the operations of the API.
+const (
+	ScopeArticlesWrite = "articles:write"
+	ScopeCommentsWrite = "comments:write"
+	ScopeProfilesWrite = "profiles:write"
+	ScopeUserWrite     = "user:write"
+	// ScopeTokensWrite is only held by sessions, so that a personal access token
+	// can neither issue other tokens nor log out.
+	ScopeTokensWrite = "tokens:write"
+)
+
+// PersonalAccessTokenScopes returns the scopes that can be granted to a personal access token.
+func PersonalAccessTokenScopes() []string {
+	return []string{ScopeArticlesWrite, ScopeCommentsWrite, ScopeProfilesWrite, ScopeUserWrite}
+}
+
+// SessionScopes returns the scopes of a logged in user, all of them.
+func SessionScopes() []string {
+	return append(PersonalAccessTokenScopes(), ScopeTokensWrite)
+}
+
+// RefreshToken is the persisted side of a refresh token, only its hash is stored.
+// All the tokens rotated from the same login share the same family.
+type RefreshToken struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	FamilyID  uuid.UUID  `db:"family_id" json:"family_id"`
+	UserID    uuid.UUID  `db:"appuser_id" json:"appuser_id"`
+	TokenHash string     `db:"token_hash" json:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at" json:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at" json:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+}
+
+// IssuedRefreshToken is a refresh token as handed to the client.
+// Its family identifies the session, from the login to the logout.
+type IssuedRefreshToken struct {
+	Token    string
+	FamilyID uuid.UUID
+}
+
+//nolint:iface //for extension
+type RefreshTokenService interface {
+	CreateRefreshToken(ctx context.Context, userID uuid.UUID) (*IssuedRefreshToken, error)
+	RotateRefreshToken(ctx context.Context, token string) (*User, *IssuedRefreshToken, error)
+}
+
+//nolint:iface //for extension
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, refreshToken *RefreshToken) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// RotateRefreshToken revokes the current token and stores its replacement,
+	// it returns ErrRefreshTokenReused if the current token was already revoked.
+	RotateRefreshToken(ctx context.Context, currentID uuid.UUID, next *RefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+}
+
+// TokenRevocation is the revocation state of an access token.
+type TokenRevocation struct {
+	Revoked bool `db:"revoked" json:"revoked"`
+	// all the tokens of the user issued before this time are revoked
+	RevokedBefore *time.Time `db:"revoked_before" json:"revoked_before"`
+}
+
+//nolint:iface //for extension
+type TokenRevocationService interface {
+	// RevokeSession revokes an access token and the refresh tokens of its session.
+	RevokeSession(
+		ctx context.Context,
+		userID, tokenID uuid.UUID,
+		expiresAt time.Time,
+		sessionID uuid.UUID,
+	) error
+	// RevokeAllSessions revokes every access and refresh token of the user,
+	// personal access tokens included.
+	RevokeAllSessions(ctx context.Context, userID uuid.UUID) error
+	IsTokenRevoked(
+		ctx context.Context,
+		userID, tokenID uuid.UUID,
+		issuedAt time.Time,
+	) (bool, error)
+}
+
+//nolint:iface //for extension
+type TokenRevocationRepository interface {
+	RevokeToken(ctx context.Context, userID, tokenID uuid.UUID, expiresAt time.Time) error
+	// RevokeAllTokens revokes all the tokens of the user, and returns the revocation time.
+	RevokeAllTokens(ctx context.Context, userID uuid.UUID) (time.Time, error)
+	GetTokenRevocation(ctx context.Context, userID, tokenID uuid.UUID) (*TokenRevocation, error)
+}
+
+// PersonalAccessToken is the persisted side of a long-lived access token,
+// its ID is the jti of the token and only its hash is stored.
+type PersonalAccessToken struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	UserID    uuid.UUID  `db:"appuser_id" json:"appuser_id"`
+	Name      string     `db:"name" json:"name"`
+	Scopes    []string   `db:"scopes" json:"scopes"`
+	TokenHash string     `db:"token_hash" json:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at" json:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at" json:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+}
+
+// NewPersonalAccessToken validates the requested token, it is to be signed
+// then created once its hash is set.
+func NewPersonalAccessToken(
+	userID uuid.UUID,
+	name string,
+	scopes []string,
+	expiresAt *time.Time,
+) (*PersonalAccessToken, error) {
+	tokenID, errU := uuid.NewV7()
+	if errU != nil {
+		return nil, fmt.Errorf("could not generate uuid: %w", errU)
+	}
+
+	token := &PersonalAccessToken{
+		ID:        tokenID,
+		UserID:    userID,
+		Name:      name,
+		Scopes:    scopes,
+		ExpiresAt: time.Now().Add(defaultPersonalAccessTokenExpiry),
+	}
+
+	if expiresAt != nil {
+		token.ExpiresAt = *expiresAt
+	}
+
+	if err := token.validate(); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// validate reports every invalid field at once.
+func (pat *PersonalAccessToken) validate() error {
+	var errs []error
+
+	if pat.Name == "" {
+		errs = append(errs, NewFieldError("name", "is required", ErrInvalidPersonalAccessToken))
+	}
+
+	if !time.Now().Before(pat.ExpiresAt) {
+		errs = append(errs, NewFieldError("expiresAt", "is in the past", ErrInvalidPersonalAccessToken))
+	}
+
+	if len(pat.Scopes) == 0 {
+		errs = append(errs, NewFieldError("scopes", "is required", ErrInvalidPersonalAccessToken))
+	}
+
+	for _, scope := range pat.Scopes {
+		if !slices.Contains(PersonalAccessTokenScopes(), scope) {
+			errs = append(errs, NewFieldError("scopes", "unknown scope "+scope, ErrInvalidScope))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+//nolint:iface //for extension
+type PersonalAccessTokenService interface {
+	CreatePersonalAccessToken(ctx context.Context, token *PersonalAccessToken) error
+	GetPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]*PersonalAccessToken, error)
+	RevokePersonalAccessToken(ctx context.Context, userID, tokenID uuid.UUID) error
+}
+
+//nolint:iface //for extension
+type PersonalAccessTokenRepository interface {
+	CreatePersonalAccessToken(ctx context.Context, token *PersonalAccessToken) error
+	// GetPersonalAccessTokens returns the tokens of the user that are neither revoked nor expired.
+	GetPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]*PersonalAccessToken, error)
+	// RevokePersonalAccessToken revokes the token and adds it to the revocation list,
+	// it returns ErrPersonalAccessTokenNotFound if the user has no such active token.
+	RevokePersonalAccessToken(ctx context.Context, userID, tokenID uuid.UUID) error
+}
+
+// newRefreshToken generates an opaque token and its persisted counterpart.
+func newRefreshToken(
+	userID, familyID uuid.UUID,
+	ttl time.Duration,
+) (string, *RefreshToken, error) {
+	tokenID, errU := uuid.NewV7()
+	if errU != nil {
+		return "", nil, fmt.Errorf("could not generate uuid: %w", errU)
+	}
+
+	if familyID == uuid.Nil {
+		familyID = tokenID
+	}
+
+	raw := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, fmt.Errorf("could not generate refresh token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return token, &RefreshToken{
+		ID:        tokenID,
+		FamilyID:  familyID,
+		UserID:    userID,
+		TokenHash: HashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	}, nil
+}
+
+// HashToken returns the hash under which a refresh or personal access token is stored.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
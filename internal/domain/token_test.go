@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV7())
+	familyID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name     string
+		familyID uuid.UUID
+	}{
+		{
+			name:     "new family",
+			familyID: uuid.Nil,
+		},
+		{
+			name:     "existing family",
+			familyID: familyID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, refreshToken, err := newRefreshToken(userID, tt.familyID, time.Hour)
+			if err != nil {
+				t.Fatalf("newRefreshToken() error = %v", err)
+			}
+
+			if refreshToken.TokenHash != HashToken(token) || refreshToken.TokenHash == token {
+				t.Errorf("newRefreshToken() hash = %v, token %v", refreshToken.TokenHash, token)
+			}
+
+			wantFamilyID := tt.familyID
+			if wantFamilyID == uuid.Nil {
+				wantFamilyID = refreshToken.ID
+			}
+
+			if refreshToken.FamilyID != wantFamilyID || refreshToken.UserID != userID {
+				t.Errorf("newRefreshToken() = %v, want family %v", refreshToken, wantFamilyID)
+			}
+		})
+	}
+}
+
+func TestNewPersonalAccessToken(t *testing.T) {
+	t.Parallel()
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		tokenName string
+		scopes    []string
+		expiresAt *time.Time
+		wantErr   error
+	}{
+		{
+			name:      "default expiry",
+			tokenName: "release notes",
+			scopes:    []string{ScopeArticlesWrite},
+			expiresAt: nil,
+			wantErr:   nil,
+		},
+		{
+			name:      "requested expiry",
+			tokenName: "release notes",
+			scopes:    []string{ScopeArticlesWrite, ScopeCommentsWrite},
+			expiresAt: &future,
+			wantErr:   nil,
+		},
+		{
+			name:      "already expired",
+			tokenName: "release notes",
+			scopes:    []string{ScopeArticlesWrite},
+			expiresAt: &past,
+			wantErr:   ErrInvalidPersonalAccessToken,
+		},
+		{
+			name:      "missing name",
+			tokenName: "",
+			scopes:    []string{ScopeArticlesWrite},
+			expiresAt: nil,
+			wantErr:   ErrInvalidPersonalAccessToken,
+		},
+		{
+			name:      "missing scopes",
+			tokenName: "release notes",
+			scopes:    nil,
+			expiresAt: nil,
+			wantErr:   ErrInvalidPersonalAccessToken,
+		},
+		{
+			name:      "session only scope",
+			tokenName: "release notes",
+			scopes:    []string{ScopeTokensWrite},
+			expiresAt: nil,
+			wantErr:   ErrInvalidScope,
+		},
+		{
+			name:      "unknown scope",
+			tokenName: "release notes",
+			scopes:    []string{"admin"},
+			expiresAt: nil,
+			wantErr:   ErrInvalidScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := NewPersonalAccessToken(uuid.Nil, tt.tokenName, tt.scopes, tt.expiresAt)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewPersonalAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && !token.ExpiresAt.After(time.Now()) {
+				t.Errorf("NewPersonalAccessToken() expires at %v", token.ExpiresAt)
+			}
+		})
+	}
+}
@@ -16,11 +16,25 @@ type User struct {
 	Image     string    `db:"img" json:"img"`
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	Version   int       `db:"version" json:"version"`
 }
 
 //nolint:iface //for extension
 type UserService interface {
-	UserRepository
+	RegisterUser(
+		ctx context.Context,
+		userID uuid.UUID,
+		email, username, password string,
+	) (*User, error)
+	AuthUser(ctx context.Context, email, password string) (*User, error)
+	GetUser(ctx context.Context, username string) (*User, error)
+	GetCurrentUser(ctx context.Context, userID uuid.UUID) (*User, error)
+	UpdateUser(
+		ctx context.Context,
+		userID uuid.UUID,
+		username, email, password, bio, image *string,
+		expectedVersions []int,
+	) (*User, error)
 }
 
 //nolint:iface //for extension
@@ -30,26 +44,56 @@ type UserRepository interface {
 		userID uuid.UUID,
 		email, username, password string,
 	) (*User, error)
-	AuthUser(ctx context.Context, email, password string) (*User, string, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	GetUser(ctx context.Context, username string) (*User, error)
 	GetCurrentUser(ctx context.Context, userID uuid.UUID) (*User, error)
 	UpdateUser(
 		ctx context.Context,
 		userID uuid.UUID,
 		username, email, password, bio, image *string,
+		expectedVersions []int,
 	) (*User, error)
 }
 
+// Profile is a user as seen by another one, Following tells whether the viewer follows them.
+// ArticlesCount only counts the published articles.
 type Profile struct {
-	Username  string `db:"username" json:"username"`
-	Bio       string `db:"bio" json:"bio"`
-	Image     string `db:"img" json:"img"`
-	Following bool   `db:"following" json:"following"`
+	Username       string `db:"username" json:"username"`
+	Bio            string `db:"bio" json:"bio"`
+	Image          string `db:"img" json:"img"`
+	Following      bool   `db:"following" json:"following"`
+	FollowersCount int    `db:"followers_count" json:"followers_count"`
+	FollowingCount int    `db:"following_count" json:"following_count"`
+	ArticlesCount  int    `db:"articles_count" json:"articles_count"`
+}
+
+// Follow is a profile in the followers or the following of a user, with when the follow started.
+type Follow struct {
+	Profile
+
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	FollowedAt time.Time `db:"followed_at" json:"followed_at"`
+}
+
+// Cursor returns the keyset position of the follow in the listings.
+func (f *Follow) Cursor() Cursor {
+	return Cursor{CreatedAt: f.FollowedAt, ID: f.UserID.String()}
+}
+
+// FollowPage is a page of the followers or the following of a user, latest follow first.
+// NextCursor is empty on the last page.
+type FollowPage struct {
+	Follows    []*Follow
+	NextCursor string
 }
 
 //nolint:iface //for extension
 type ProfileService interface {
-	ProfileRepository
+	GetProfile(ctx context.Context, userID uuid.UUID, username string) (*Profile, error)
+	FollowUser(ctx context.Context, userID uuid.UUID, followUsername string) (*Profile, error)
+	UnfollowUser(ctx context.Context, userID uuid.UUID, unfollowUsername string) (*Profile, error)
+	GetFollowers(ctx context.Context, userID uuid.UUID, username string, limit *int, cursor *string) (*FollowPage, error)
+	GetFollowing(ctx context.Context, userID uuid.UUID, username string, limit *int, cursor *string) (*FollowPage, error)
 }
 
 //nolint:iface //for extension
@@ -57,4 +101,6 @@ type ProfileRepository interface {
 	GetProfile(ctx context.Context, userID uuid.UUID, username string) (*Profile, error)
 	FollowUser(ctx context.Context, userID uuid.UUID, followUsername string) (*Profile, error)
 	UnfollowUser(ctx context.Context, userID uuid.UUID, unfollowUsername string) (*Profile, error)
+	GetFollowers(ctx context.Context, userID uuid.UUID, username string, limit *int, after *Cursor) ([]*Follow, error)
+	GetFollowing(ctx context.Context, userID uuid.UUID, username string, limit *int, after *Cursor) ([]*Follow, error)
 }
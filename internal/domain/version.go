@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ErrVersionMismatch fails a conditional write, the resource changed since the version it was based on.
+var ErrVersionMismatch = fmt.Errorf("%w: version mismatch", ErrPreconditionFailed)
+
+// VersionMatches reports whether a resource at version may be written under the expected versions,
+// nil expected versions make the write unconditional.
+func VersionMatches(version int, expected []int) bool {
+	return expected == nil || slices.Contains(expected, version)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "testing"
+
+func TestVersionMatches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		version  int
+		expected []int
+		want     bool
+	}{
+		{
+			name:     "unconditional",
+			version:  3,
+			expected: nil,
+			want:     true,
+		},
+		{
+			name:     "current version",
+			version:  3,
+			expected: []int{3},
+			want:     true,
+		},
+		{
+			name:     "one of the versions",
+			version:  3,
+			expected: []int{2, 3},
+			want:     true,
+		},
+		{
+			name:     "stale version",
+			version:  3,
+			expected: []int{2},
+			want:     false,
+		},
+		{
+			name:     "no acceptable version",
+			version:  3,
+			expected: []int{},
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := VersionMatches(tt.version, tt.expected); got != tt.want {
+				t.Errorf("VersionMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,15 +7,19 @@ import (
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
-	"github.com/go-chi/jwtauth/v5"
 	"github.com/google/uuid"
 	"github.com/induzo/gocom/http/middleware/writablecontext"
 	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"realworld/internal/domain"
 )
 
-func NewAuthenticator(auth *jwtauth.JWTAuth) openapi3filter.AuthenticationFunc {
+func NewAuthenticator(
+	keys *JWTKeys,
+	revocations domain.TokenRevocationService,
+) openapi3filter.AuthenticationFunc {
 	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
-		return Authenticate(ctx, auth, input)
+		return Authenticate(ctx, keys, revocations, input)
 	}
 }
 
@@ -51,9 +55,35 @@ func getUserIDFromContext(ctx context.Context) uuid.UUID {
 	return userID
 }
 
+func getJWTFromContext(ctx context.Context) jwt.Token {
+	tokenAny, ok := writablecontext.FromContext(ctx).Get(JWTContextKey)
+	if !ok {
+		return nil
+	}
+
+	if token, ok := tokenAny.(jwt.Token); ok {
+		return token
+	}
+
+	return nil
+}
+
 const (
 	UserIDContextKey = "userID"
 	TokenContextKey  = "token"
+	JWTContextKey    = "jwt"
+)
+
+// SessionIDClaim is the private claim holding the refresh token family of an access token.
+const SessionIDClaim = "sid"
+
+var (
+	ErrTokenRevoked   = fmt.Errorf("%w: token revoked", domain.ErrUnauthorized)
+	ErrMissingTokenID = fmt.Errorf("%w: missing token id", domain.ErrUnauthorized)
+	ErrNoTokenFound   = fmt.Errorf("%w: no token found", domain.ErrUnauthorized)
+	// ErrSessionRequired is returned to a personal access token changing the credentials,
+	// which only a session can do as a new password issues a new session.
+	ErrSessionRequired = fmt.Errorf("%w: credentials can only be changed from a session", domain.ErrForbidden)
 )
 
 type ErrWrongSecSchemeError struct {
@@ -69,11 +99,12 @@ func (e ErrWrongSecSchemeError) Error() string {
 	)
 }
 
-// Authenticate uses the specified validator to ensure a JWT is valid, then makes
-// sure that the claims provided by the JWT match the scopes as required in the API.
+// Authenticate uses the specified validator to ensure a JWT is valid and not revoked,
+// then makes sure that the claims provided by the JWT match the scopes as required in the API.
 func Authenticate(
-	_ context.Context,
-	auth *jwtauth.JWTAuth,
+	ctx context.Context,
+	keys *JWTKeys,
+	revocations domain.TokenRevocationService,
 	input *openapi3filter.AuthenticationInput,
 ) error {
 	// // Our security scheme is named Token, ensure this is the case
@@ -84,12 +115,13 @@ func Authenticate(
 		}
 	}
 
-	// verifier the JWS, which will also validate the signature.
-	token, err := jwtauth.VerifyRequest(
-		auth,
-		input.RequestValidationInput.Request,
-		findTokenInHeader,
-	)
+	tokenString := findTokenInHeader(input.RequestValidationInput.Request)
+	if tokenString == "" {
+		return ErrNoTokenFound
+	}
+
+	// verify the JWS against the published keys, which will also validate the signature.
+	token, err := keys.Decode(tokenString)
 	if err != nil {
 		return fmt.Errorf("validating JWS: %w", err)
 	}
@@ -98,11 +130,43 @@ func Authenticate(
 		return fmt.Errorf("token claims don't match: %w", err)
 	}
 
+	if err := checkTokenRevocation(ctx, revocations, token); err != nil {
+		return err
+	}
+
 	//nolint:contextcheck // context contains a writable context
 	// Add the user ID to the context
 	reqstore := writablecontext.FromContext(input.RequestValidationInput.Request.Context())
 	reqstore.Set(UserIDContextKey, token.Subject())
-	reqstore.Set(TokenContextKey, findTokenInHeader(input.RequestValidationInput.Request))
+	reqstore.Set(TokenContextKey, tokenString)
+	reqstore.Set(JWTContextKey, token)
+
+	return nil
+}
+
+func checkTokenRevocation(
+	ctx context.Context,
+	revocations domain.TokenRevocationService,
+	token jwt.Token,
+) error {
+	tokenID, errT := uuid.Parse(token.JwtID())
+	if errT != nil {
+		return fmt.Errorf("%w: %w", ErrMissingTokenID, errT)
+	}
+
+	userID, errU := uuid.Parse(token.Subject())
+	if errU != nil {
+		return fmt.Errorf("invalid token subject: %w", errU)
+	}
+
+	revoked, err := revocations.IsTokenRevoked(ctx, userID, tokenID, token.IssuedAt())
+	if err != nil {
+		return fmt.Errorf("checking token revocation: %w", err)
+	}
+
+	if revoked {
+		return ErrTokenRevoked
+	}
 
 	return nil
 }
@@ -0,0 +1,174 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"realworld/internal/domain"
+)
+
+type stubRevocations struct {
+	revoked bool
+}
+
+func (stubRevocations) RevokeSession(
+	_ context.Context,
+	_, _ uuid.UUID,
+	_ time.Time,
+	_ uuid.UUID,
+) error {
+	return nil
+}
+
+func (stubRevocations) RevokeAllSessions(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+func (sr stubRevocations) IsTokenRevoked(
+	_ context.Context,
+	_, _ uuid.UUID,
+	_ time.Time,
+) (bool, error) {
+	return sr.revoked, nil
+}
+
+func TestCheckTokenRevocation(t *testing.T) {
+	t.Parallel()
+
+	newToken := func(jti string) jwt.Token {
+		token, err := jwt.NewBuilder().
+			JwtID(jti).
+			Subject(uuid.Must(uuid.NewV7()).String()).
+			IssuedAt(time.Now()).
+			Build()
+		if err != nil {
+			t.Fatalf("could not build token: %v", err)
+		}
+
+		return token
+	}
+
+	tests := []struct {
+		name    string
+		token   jwt.Token
+		revoked bool
+		wantErr error
+	}{
+		{
+			name:  "valid token",
+			token: newToken(uuid.Must(uuid.NewV7()).String()),
+		},
+		{
+			name:    "revoked token",
+			token:   newToken(uuid.Must(uuid.NewV7()).String()),
+			revoked: true,
+			wantErr: ErrTokenRevoked,
+		},
+		{
+			name:    "token without jti",
+			token:   newToken(""),
+			wantErr: ErrMissingTokenID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkTokenRevocation(t.Context(), stubRevocations{revoked: tt.revoked}, tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkTokenRevocation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckTokenClaims(t *testing.T) {
+	t.Parallel()
+
+	keys, errK := NewHMACJWTKeys("secret")
+	if errK != nil {
+		t.Fatalf("NewHMACJWTKeys() error = %v", errK)
+	}
+
+	srv := NewStrictAPIServer(nil, keys, time.Minute)
+
+	pat, errN := domain.NewPersonalAccessToken(
+		uuid.Must(uuid.NewV7()),
+		"release notes",
+		[]string{domain.ScopeArticlesWrite},
+		nil,
+	)
+	if errN != nil {
+		t.Fatalf("domain.NewPersonalAccessToken() error = %v", errN)
+	}
+
+	decode := func(jws string, err error) jwt.Token {
+		if err != nil {
+			t.Fatalf("could not encode token: %v", err)
+		}
+
+		token, errD := keys.Decode(jws)
+		if errD != nil {
+			t.Fatalf("JWTKeys.Decode() error = %v", errD)
+		}
+
+		return token
+	}
+
+	session := decode(srv.encodeAccessToken(pat.UserID, uuid.Must(uuid.NewV7())))
+	personal := decode(srv.encodePersonalAccessToken(pat))
+
+	tests := []struct {
+		name    string
+		token   jwt.Token
+		scopes  []string
+		wantErr bool
+	}{
+		{
+			name:    "session token holds every scope",
+			token:   session,
+			scopes:  domain.SessionScopes(),
+			wantErr: false,
+		},
+		{
+			name:    "personal access token with the scope",
+			token:   personal,
+			scopes:  []string{domain.ScopeArticlesWrite},
+			wantErr: false,
+		},
+		{
+			name:    "personal access token without the scope",
+			token:   personal,
+			scopes:  []string{domain.ScopeCommentsWrite},
+			wantErr: true,
+		},
+		{
+			name:    "personal access token can't manage tokens",
+			token:   personal,
+			scopes:  []string{domain.ScopeTokensWrite},
+			wantErr: true,
+		},
+		{
+			name:    "no scope required",
+			token:   personal,
+			scopes:  nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := CheckTokenClaims(tt.scopes, tt.token); (err != nil) != tt.wantErr {
+				t.Errorf("CheckTokenClaims() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"go.opentelemetry.io/otel/trace"
+
+	"realworld/internal/domain"
+)
+
+const (
+	ContentTypeProblemJSON = "application/problem+json"
+	ContentTypeJSON        = "application/json"
+)
+
+// problem types, relative to the api
+const (
+	problemTypeBadRequest       = "/problems/bad-request"
+	problemTypeUnauthorized     = "/problems/unauthorized"
+	problemTypeForbidden        = "/problems/forbidden"
+	problemTypeNotFound         = "/problems/not-found"
+	problemTypeMethodNotAllowed = "/problems/method-not-allowed"
+	problemTypeConflict         = "/problems/conflict"
+	problemTypePrecondition     = "/problems/precondition-failed"
+	problemTypeValidation       = "/problems/validation-error"
+	problemTypeInternal         = "/problems/internal-error"
+)
+
+// domainErrors are the errors the domain errors are built on.
+var domainErrors = []error{
+	domain.ErrUnauthorized,
+	domain.ErrForbidden,
+	domain.ErrNotFound,
+	domain.ErrConflict,
+	domain.ErrPreconditionFailed,
+	domain.ErrValidation,
+}
+
+// errorStatus maps the domain errors to http status codes,
+// anything else is an internal error.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, domain.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, domain.ErrValidation):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func problemType(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return problemTypeBadRequest
+	case http.StatusUnauthorized:
+		return problemTypeUnauthorized
+	case http.StatusForbidden:
+		return problemTypeForbidden
+	case http.StatusNotFound:
+		return problemTypeNotFound
+	case http.StatusMethodNotAllowed:
+		return problemTypeMethodNotAllowed
+	case http.StatusConflict:
+		return problemTypeConflict
+	case http.StatusPreconditionFailed:
+		return problemTypePrecondition
+	case http.StatusUnprocessableEntity:
+		return problemTypeValidation
+	default:
+		return problemTypeInternal
+	}
+}
+
+// errorWriter is the single error pipeline of the api, for the strict handlers
+// and for the request validator.
+// Errors are written as RFC 7807 problems, or as the RealWorld GenericErrorModel
+// when the legacy format is set.
+type errorWriter struct {
+	logger *slog.Logger
+	legacy bool
+}
+
+func newErrorWriter(logger *slog.Logger, legacy bool) *errorWriter {
+	return &errorWriter{
+		logger: logger,
+		legacy: legacy,
+	}
+}
+
+// ResponseError handles the errors returned by the strict handlers.
+// The client is only told the domain error, the whole chain is logged.
+func (ew *errorWriter) ResponseError(respW http.ResponseWriter, req *http.Request, err error) {
+	status := errorStatus(err)
+
+	if status < http.StatusInternalServerError {
+		ew.logger.InfoContext(req.Context(), "request failed", slog.Int("status", status), slog.Any("err", err))
+	}
+
+	ew.write(respW, req, status, errorDetail(err), err, fieldErrors(err))
+}
+
+// RequestError handles the request decoding errors of the strict handlers.
+func (ew *errorWriter) RequestError(respW http.ResponseWriter, req *http.Request, err error) {
+	ew.write(respW, req, http.StatusBadRequest, firstLine(err.Error()), err, nil)
+}
+
+// ValidationError handles the errors of the oapi request validator.
+func (ew *errorWriter) ValidationError(
+	_ context.Context,
+	err error,
+	respW http.ResponseWriter,
+	req *http.Request,
+	opts oapimiddleware.ErrorHandlerOpts,
+) {
+	status := opts.StatusCode
+
+	var secErr *openapi3filter.SecurityRequirementsError
+
+	switch {
+	case errors.Is(err, routers.ErrMethodNotAllowed):
+		status = http.StatusMethodNotAllowed
+	case errors.As(err, &secErr):
+		// the token errors are not disclosed
+		ew.write(respW, req, status, domain.ErrUnauthorized.Error(), domain.ErrUnauthorized, nil)
+
+		return
+	}
+
+	ew.write(respW, req, status, firstLine(err.Error()), err, requestInvalidParams(err))
+}
+
+func (ew *errorWriter) write(
+	respW http.ResponseWriter,
+	req *http.Request,
+	status int,
+	detail string,
+	err error,
+	invalidParams []InvalidParam,
+) {
+	if status >= http.StatusInternalServerError {
+		ew.logger.ErrorContext(req.Context(), "internal error", slog.Any("err", err))
+
+		detail = http.StatusText(status)
+	}
+
+	if ew.legacy {
+		messages := []string{detail}
+		for _, param := range invalidParams {
+			messages = append(messages, param.Name+": "+param.Reason)
+		}
+
+		var body GenericErrorModel
+
+		body.Errors.Body = messages
+
+		writeJSON(respW, ContentTypeJSON, status, body)
+
+		return
+	}
+
+	problem := ProblemDetails{
+		Type:   problemType(status),
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: &detail,
+	}
+
+	if req.URL != nil {
+		instance := req.URL.Path
+		problem.Instance = &instance
+	}
+
+	if spanCtx := trace.SpanContextFromContext(req.Context()); spanCtx.HasTraceID() {
+		traceID := spanCtx.TraceID().String()
+		problem.TraceId = &traceID
+	}
+
+	if len(invalidParams) > 0 {
+		problem.InvalidParams = &invalidParams
+	}
+
+	writeJSON(respW, ContentTypeProblemJSON, status, problem)
+}
+
+func writeJSON(respW http.ResponseWriter, contentType string, status int, body any) {
+	respW.Header().Set("Content-Type", contentType)
+	respW.WriteHeader(status)
+
+	_ = json.NewEncoder(respW).Encode(body)
+}
+
+// errorDetail describes the domain error to the client, without the context
+// added by the callers nor the errors of the repository it wraps.
+func errorDetail(err error) string {
+	if detail, ok := domainErrorDetail(err); ok {
+		return detail
+	}
+
+	return http.StatusText(errorStatus(err))
+}
+
+// domainErrorDetail returns the message of the most refined domain error of the chain.
+// The domain errors refine the error they wrap, as in "not found: trashed article",
+// where the callers prefix it with their context.
+func domainErrorDetail(err error) (string, bool) {
+	if slices.Contains(domainErrors, err) {
+		return err.Error(), true
+	}
+
+	switch wrapped := err.(type) { //nolint:errorlint // walking the error tree
+	case interface{ Unwrap() []error }:
+		for _, e := range wrapped.Unwrap() {
+			if detail, ok := domainErrorDetail(e); ok {
+				return detail, true
+			}
+		}
+
+		return "", false
+	case interface{ Unwrap() error }:
+		detail, ok := domainErrorDetail(wrapped.Unwrap())
+		if ok && strings.HasPrefix(err.Error(), detail+": ") {
+			return err.Error(), true
+		}
+
+		return detail, ok
+	default:
+		return "", false
+	}
+}
+
+// fieldErrors collects the domain field errors, joined or wrapped.
+func fieldErrors(err error) []InvalidParam {
+	// errors.As would stop at the first field error of a join
+	switch wrapped := err.(type) { //nolint:errorlint // walking the error tree
+	case *domain.FieldError:
+		return []InvalidParam{{Name: wrapped.Field, Reason: wrapped.Reason}}
+	case interface{ Unwrap() []error }:
+		var params []InvalidParam
+		for _, e := range wrapped.Unwrap() {
+			params = append(params, fieldErrors(e)...)
+		}
+
+		return params
+	case interface{ Unwrap() error }:
+		return fieldErrors(wrapped.Unwrap())
+	default:
+		return nil
+	}
+}
+
+// requestInvalidParams describes the parameters and body fields rejected by the validator.
+func requestInvalidParams(err error) []InvalidParam {
+	var multiErr openapi3.MultiError
+	if errors.As(err, &multiErr) {
+		var params []InvalidParam
+		for _, e := range multiErr {
+			params = append(params, requestInvalidParams(e)...)
+		}
+
+		return params
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return nil
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(reqErr.Err, &schemaErr) {
+		name := strings.Join(schemaErr.JSONPointer(), ".")
+		if reqErr.Parameter != nil {
+			name = reqErr.Parameter.Name
+		}
+
+		return []InvalidParam{{Name: name, Reason: schemaErr.Reason}}
+	}
+
+	if reqErr.Parameter != nil {
+		return []InvalidParam{{Name: reqErr.Parameter.Name, Reason: reqErr.Reason}}
+	}
+
+	return nil
+}
+
+// firstLine keeps the message readable, validation errors span many lines.
+func firstLine(msg string) string {
+	line, _, _ := strings.Cut(msg, "\n")
+
+	return line
+}
@@ -0,0 +1,286 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"go.opentelemetry.io/otel/trace"
+
+	"realworld/internal/domain"
+)
+
+func newTestErrorWriter(legacy bool) *errorWriter {
+	return newErrorWriter(slog.New(slog.NewTextHandler(io.Discard, nil)), legacy)
+}
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) ProblemDetails {
+	t.Helper()
+
+	if got := rec.Header().Get("Content-Type"); got != ContentTypeProblemJSON {
+		t.Errorf("Content-Type = %v, want %v", got, ContentTypeProblemJSON)
+	}
+
+	var problem ProblemDetails
+	if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+		t.Fatalf("could not decode problem: %v", err)
+	}
+
+	return problem
+}
+
+func TestErrorWriter_ResponseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantType   string
+		wantDetail string
+	}{
+		{
+			name:       "not found",
+			err:        fmt.Errorf("get article: %w", domain.ErrNotFound),
+			wantStatus: http.StatusNotFound,
+			wantType:   problemTypeNotFound,
+			wantDetail: "not found",
+		},
+		{
+			name:       "forbidden",
+			err:        fmt.Errorf("delete article: %w", domain.ErrForbidden),
+			wantStatus: http.StatusForbidden,
+			wantType:   problemTypeForbidden,
+			wantDetail: "forbidden",
+		},
+		{
+			name:       "conflict",
+			err:        fmt.Errorf("create user: %w", domain.ErrConflict),
+			wantStatus: http.StatusConflict,
+			wantType:   problemTypeConflict,
+			wantDetail: "conflict",
+		},
+		{
+			name:       "precondition failed",
+			err:        fmt.Errorf("update article: %w", domain.ErrVersionMismatch),
+			wantStatus: http.StatusPreconditionFailed,
+			wantType:   problemTypePrecondition,
+			wantDetail: "precondition failed: version mismatch",
+		},
+		{
+			name:       "unauthorized",
+			err:        fmt.Errorf("login: %w", domain.ErrInvalidCredentials),
+			wantStatus: http.StatusUnauthorized,
+			wantType:   problemTypeUnauthorized,
+			wantDetail: "unauthorized: invalid credentials",
+		},
+		{
+			name: "repository error is not disclosed",
+			err: fmt.Errorf(
+				"get article: %w",
+				fmt.Errorf("could not get article: %w", fmt.Errorf("%w: %w", domain.ErrNotFound, errors.New("no rows"))),
+			),
+			wantStatus: http.StatusNotFound,
+			wantType:   problemTypeNotFound,
+			wantDetail: "not found",
+		},
+		{
+			name: "domain refinement is kept",
+			err: fmt.Errorf(
+				"add comment: %w",
+				fmt.Errorf("%w: replies are limited to a depth of %d", domain.ErrCommentTooDeep, 3),
+			),
+			wantStatus: http.StatusUnprocessableEntity,
+			wantType:   problemTypeValidation,
+			wantDetail: "validation failed: comment nested too deep: replies are limited to a depth of 3",
+		},
+		{
+			name:       "internal error is not disclosed",
+			err:        errors.New("could not connect to 10.0.0.1"),
+			wantStatus: http.StatusInternalServerError,
+			wantType:   problemTypeInternal,
+			wantDetail: http.StatusText(http.StatusInternalServerError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			newTestErrorWriter(false).ResponseError(
+				rec,
+				httptest.NewRequest(http.MethodGet, "/articles/dragon", nil),
+				tt.err,
+			)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("errorWriter.ResponseError() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+
+			problem := decodeProblem(t, rec)
+
+			if problem.Status != tt.wantStatus || problem.Type != tt.wantType ||
+				problem.Title != http.StatusText(tt.wantStatus) {
+				t.Errorf("errorWriter.ResponseError() problem = %+v", problem)
+			}
+
+			if problem.Detail == nil || *problem.Detail != tt.wantDetail {
+				t.Errorf("errorWriter.ResponseError() detail = %v, want %v", problem.Detail, tt.wantDetail)
+			}
+
+			if problem.Instance == nil || *problem.Instance != "/articles/dragon" {
+				t.Errorf("errorWriter.ResponseError() instance = %v", problem.Instance)
+			}
+		})
+	}
+}
+
+func TestErrorWriter_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	fieldErr := errors.Join(
+		domain.NewFieldError("name", "is required", domain.ErrInvalidPersonalAccessToken),
+		domain.NewFieldError("scopes", "unknown scope admin", domain.ErrInvalidScope),
+	)
+
+	traceID := trace.TraceID{1, 2, 3}
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  trace.SpanID{1},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/user/tokens", nil)
+	req = req.WithContext(trace.ContextWithSpanContext(req.Context(), spanCtx))
+
+	rec := httptest.NewRecorder()
+	newTestErrorWriter(false).ResponseError(rec, req, fmt.Errorf("create token: %w", fieldErr))
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("errorWriter.ResponseError() status = %v, want %v", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	problem := decodeProblem(t, rec)
+
+	if problem.TraceId == nil || *problem.TraceId != traceID.String() {
+		t.Errorf("errorWriter.ResponseError() trace_id = %v, want %v", problem.TraceId, traceID)
+	}
+
+	want := []InvalidParam{
+		{Name: "name", Reason: "is required"},
+		{Name: "scopes", Reason: "unknown scope admin"},
+	}
+
+	if problem.InvalidParams == nil || !slices.Equal(*problem.InvalidParams, want) {
+		t.Errorf("errorWriter.ResponseError() invalid_params = %v, want %v", problem.InvalidParams, want)
+	}
+}
+
+func TestErrorWriter_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		status     int
+		wantStatus int
+		wantParams []InvalidParam
+	}{
+		{
+			name: "invalid query parameter",
+			err: &openapi3filter.RequestError{
+				Parameter: &openapi3.Parameter{Name: "limit"},
+				Err:       &openapi3.SchemaError{Reason: "number must be at least 1"},
+			},
+			status:     http.StatusBadRequest,
+			wantStatus: http.StatusBadRequest,
+			wantParams: []InvalidParam{{Name: "limit", Reason: "number must be at least 1"}},
+		},
+		{
+			name: "missing token",
+			err: &openapi3filter.SecurityRequirementsError{
+				Errors: []error{ErrNoTokenFound},
+			},
+			status:     http.StatusUnauthorized,
+			wantStatus: http.StatusUnauthorized,
+			wantParams: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/articles", nil)
+			rec := httptest.NewRecorder()
+
+			newTestErrorWriter(false).ValidationError(
+				req.Context(),
+				tt.err,
+				rec,
+				req,
+				oapimiddleware.ErrorHandlerOpts{StatusCode: tt.status},
+			)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("errorWriter.ValidationError() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+
+			problem := decodeProblem(t, rec)
+
+			var got []InvalidParam
+			if problem.InvalidParams != nil {
+				got = *problem.InvalidParams
+			}
+
+			if !slices.Equal(got, tt.wantParams) {
+				t.Errorf("errorWriter.ValidationError() invalid_params = %v, want %v", got, tt.wantParams)
+			}
+		})
+	}
+}
+
+func TestErrorWriter_Legacy(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	newTestErrorWriter(true).ResponseError(
+		rec,
+		httptest.NewRequest(http.MethodPost, "/user/tokens", nil),
+		fmt.Errorf(
+			"create token: %w",
+			domain.NewFieldError("name", "is required", domain.ErrInvalidPersonalAccessToken),
+		),
+	)
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("errorWriter.ResponseError() status = %v, want %v", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	if got := rec.Header().Get("Content-Type"); got != ContentTypeJSON {
+		t.Errorf("Content-Type = %v, want %v", got, ContentTypeJSON)
+	}
+
+	var body GenericErrorModel
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode body: %v", err)
+	}
+
+	want := []string{
+		"validation failed: invalid personal access token",
+		"name: is required",
+	}
+
+	if !slices.Equal(body.Errors.Body, want) {
+		t.Errorf("errorWriter.ResponseError() body = %v, want %v", body.Errors.Body, want)
+	}
+}
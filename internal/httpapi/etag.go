@@ -0,0 +1,121 @@
+package httpapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const weakETagPrefix = "W/"
+
+// representationETag is the strong entity tag of a response: the version of the resource,
+// then a hash of the whole representation. The counts, the flags of the viewer and the token
+// change the representation but not the version, so a cached copy is only current when both match.
+// Writes only compare the version, see ifMatchVersions.
+func representationETag(version int, representation any) (string, error) {
+	raw, err := json.Marshal(representation)
+	if err != nil {
+		return "", fmt.Errorf("could not hash representation: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+
+	return `"` + strconv.Itoa(version) + "-" + hex.EncodeToString(sum[:8]) + `"`, nil
+}
+
+// parseETags splits an If-Match or If-None-Match header into its entity tags,
+// any is set by the "*" wildcard. The parsing stops at the first malformed tag.
+func parseETags(header string) ([]string, bool) {
+	header = strings.TrimSpace(header)
+	if header == "*" {
+		return nil, true
+	}
+
+	var tags []string
+
+	for {
+		header = strings.TrimLeft(header, " \t,")
+		if header == "" {
+			return tags, false
+		}
+
+		weak := strings.HasPrefix(header, weakETagPrefix)
+		opaque := strings.TrimPrefix(header, weakETagPrefix)
+
+		if !strings.HasPrefix(opaque, `"`) {
+			return tags, false
+		}
+
+		end := strings.IndexByte(opaque[1:], '"')
+		if end < 0 {
+			return tags, false
+		}
+
+		tag := opaque[:end+2]
+		if weak {
+			tag = weakETagPrefix + tag
+		}
+
+		tags = append(tags, tag)
+		header = opaque[end+2:]
+	}
+}
+
+// ifMatchVersions returns the versions an If-Match header accepts for a write,
+// nil when it accepts any. Only the version of a tag is compared, so that the counts
+// and the flags of the viewer do not fail a write, but weak tags match no version.
+func ifMatchVersions(header *string) []int {
+	if header == nil {
+		return nil
+	}
+
+	tags, anyTag := parseETags(*header)
+	if anyTag {
+		return nil
+	}
+
+	versions := []int{}
+
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, weakETagPrefix) {
+			continue
+		}
+
+		versionPart, _, _ := strings.Cut(strings.Trim(tag, `"`), "-")
+
+		version, err := strconv.Atoi(versionPart)
+		if err != nil || strconv.Itoa(version) != versionPart {
+			continue
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions
+}
+
+// ifNoneMatch reports whether an If-None-Match header matches the entity tag,
+// in which case the client's copy is current. The comparison is weak.
+func ifNoneMatch(header *string, etag string) bool {
+	if header == nil {
+		return false
+	}
+
+	tags, anyTag := parseETags(*header)
+	if anyTag {
+		return true
+	}
+
+	etag = strings.TrimPrefix(etag, weakETagPrefix)
+
+	for _, tag := range tags {
+		if strings.TrimPrefix(tag, weakETagPrefix) == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+package httpapi
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIfMatchVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		header *string
+		want   []int
+	}{
+		{
+			name:   "no header",
+			header: nil,
+			want:   nil,
+		},
+		{
+			name:   "any version",
+			header: func() *string { s := "*"; return &s }(),
+			want:   nil,
+		},
+		{
+			name:   "one version",
+			header: func() *string { s := `"3"`; return &s }(),
+			want:   []int{3},
+		},
+		{
+			name:   "version of a representation",
+			header: func() *string { s := `"3-0123456789abcdef"`; return &s }(),
+			want:   []int{3},
+		},
+		{
+			name:   "list of versions",
+			header: func() *string { s := `"2", "3"`; return &s }(),
+			want:   []int{2, 3},
+		},
+		{
+			name:   "weak tags match no version",
+			header: func() *string { s := `W/"3"`; return &s }(),
+			want:   []int{},
+		},
+		{
+			name:   "foreign tags match no version",
+			header: func() *string { s := `"abc", "03"`; return &s }(),
+			want:   []int{},
+		},
+		{
+			name:   "malformed header",
+			header: func() *string { s := `3`; return &s }(),
+			want:   []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ifMatchVersions(tt.header)
+			if (got == nil) != (tt.want == nil) || !slices.Equal(got, tt.want) {
+				t.Errorf("ifMatchVersions() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIfNoneMatch(t *testing.T) {
+	t.Parallel()
+
+	etag := `"3-0123456789abcdef"`
+
+	tests := []struct {
+		name   string
+		header *string
+		want   bool
+	}{
+		{
+			name:   "no header",
+			header: nil,
+			want:   false,
+		},
+		{
+			name:   "any version",
+			header: func() *string { s := "*"; return &s }(),
+			want:   true,
+		},
+		{
+			name:   "current representation",
+			header: func() *string { s := `"3-0123456789abcdef"`; return &s }(),
+			want:   true,
+		},
+		{
+			name:   "weak current representation",
+			header: func() *string { s := `W/"3-0123456789abcdef"`; return &s }(),
+			want:   true,
+		},
+		{
+			name:   "current representation in a list",
+			header: func() *string { s := `"1-0123456789abcdef","3-0123456789abcdef"`; return &s }(),
+			want:   true,
+		},
+		{
+			name:   "same version, stale representation",
+			header: func() *string { s := `"3-fedcba9876543210"`; return &s }(),
+			want:   false,
+		},
+		{
+			name:   "version alone",
+			header: func() *string { s := `"3"`; return &s }(),
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ifNoneMatch(tt.header, etag); got != tt.want {
+				t.Errorf("ifNoneMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepresentationETag(t *testing.T) {
+	t.Parallel()
+
+	article := Article{Slug: "dragons", FavoritesCount: 1}
+
+	etag, errE := representationETag(3, article)
+	if errE != nil {
+		t.Fatalf("representationETag() error = %v", errE)
+	}
+
+	same, errS := representationETag(3, article)
+	if errS != nil || same != etag {
+		t.Errorf("representationETag() = %v, %v, want %v for the same representation", same, errS, etag)
+	}
+
+	article.FavoritesCount = 2
+
+	counted, errC := representationETag(3, article)
+	if errC != nil || counted == etag {
+		t.Errorf("representationETag() = %v, %v, want a new tag when a count changes", counted, errC)
+	}
+
+	if got := ifMatchVersions(&counted); !slices.Equal(got, []int{3}) {
+		t.Errorf("ifMatchVersions(%v) = %v, want the version [3]", counted, got)
+	}
+}
@@ -0,0 +1,198 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// JWTKeyStatus is the place of a key in its rotation.
+type JWTKeyStatus string
+
+const (
+	// JWTKeyActive signs new tokens, there must be exactly one active key.
+	JWTKeyActive JWTKeyStatus = "active"
+	// JWTKeyVerify only verifies tokens, either signed by a previous active key
+	// or by a next one that is being published before use.
+	JWTKeyVerify JWTKeyStatus = "verify"
+	// JWTKeyRetired is neither used nor published anymore.
+	JWTKeyRetired JWTKeyStatus = "retired"
+)
+
+var (
+	ErrNoActiveJWTKey          = errors.New("no active jwt key")
+	ErrMultipleActiveJWTKeys   = errors.New("multiple active jwt keys")
+	ErrUnsupportedJWTAlgorithm = errors.New("unsupported jwt algorithm")
+	ErrUnknownJWTKeyStatus     = errors.New("unknown jwt key status")
+	ErrEmptyJWTSecret          = errors.New("empty jwt secret")
+)
+
+//nolint:gochecknoglobals // read only list of supported asymmetric algorithms
+var supportedJWTAlgorithms = map[jwa.SignatureAlgorithm]struct{}{
+	jwa.RS256: {},
+	jwa.ES256: {},
+	jwa.EdDSA: {},
+}
+
+// JWTKeyConfig describes a signing key identified by its kid, loaded from a PEM file.
+type JWTKeyConfig struct {
+	ID             string
+	Algorithm      string
+	PrivateKeyPath string
+	Status         JWTKeyStatus
+}
+
+// JWTKeys signs tokens with the active key and verifies them against
+// every key that hasn't been retired.
+type JWTKeys struct {
+	signAlg   jwa.SignatureAlgorithm
+	signKey   any
+	verifyOpt jwt.ParseOption
+	publicSet jwk.Set
+}
+
+// NewJWTKeys loads the configured keys, exactly one of them must be active.
+func NewJWTKeys(configs []JWTKeyConfig) (*JWTKeys, error) {
+	keys := &JWTKeys{
+		publicSet: jwk.NewSet(),
+	}
+
+	for _, cfg := range configs {
+		switch cfg.Status {
+		case JWTKeyRetired:
+			continue
+		case JWTKeyActive, JWTKeyVerify:
+		default:
+			return nil, fmt.Errorf("key %s: %w: %s", cfg.ID, ErrUnknownJWTKeyStatus, cfg.Status)
+		}
+
+		privateKey, publicKey, err := loadJWTKey(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", cfg.ID, err)
+		}
+
+		if errA := keys.publicSet.AddKey(publicKey); errA != nil {
+			return nil, fmt.Errorf("key %s: could not add key to set: %w", cfg.ID, errA)
+		}
+
+		if cfg.Status == JWTKeyActive {
+			if keys.signKey != nil {
+				return nil, ErrMultipleActiveJWTKeys
+			}
+
+			keys.signAlg = jwa.SignatureAlgorithm(cfg.Algorithm)
+			keys.signKey = privateKey
+		}
+	}
+
+	if keys.signKey == nil {
+		return nil, ErrNoActiveJWTKey
+	}
+
+	keys.verifyOpt = jwt.WithKeySet(keys.publicSet)
+
+	return keys, nil
+}
+
+// NewHMACJWTKeys uses a single shared secret, for deployments
+// where no other service needs to verify the tokens.
+func NewHMACJWTKeys(secret string) (*JWTKeys, error) {
+	if secret == "" {
+		return nil, ErrEmptyJWTSecret
+	}
+
+	return &JWTKeys{
+		signAlg:   jwa.HS256,
+		signKey:   []byte(secret),
+		verifyOpt: jwt.WithKey(jwa.HS256, []byte(secret)),
+		publicSet: jwk.NewSet(),
+	}, nil
+}
+
+func loadJWTKey(cfg JWTKeyConfig) (jwk.Key, jwk.Key, error) {
+	alg := jwa.SignatureAlgorithm(cfg.Algorithm)
+	if _, ok := supportedJWTAlgorithms[alg]; !ok {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedJWTAlgorithm, cfg.Algorithm)
+	}
+
+	pemBytes, errR := os.ReadFile(cfg.PrivateKeyPath)
+	if errR != nil {
+		return nil, nil, fmt.Errorf("could not read private key: %w", errR)
+	}
+
+	privateKey, errP := jwk.ParseKey(pemBytes, jwk.WithPEM(true))
+	if errP != nil {
+		return nil, nil, fmt.Errorf("could not parse private key: %w", errP)
+	}
+
+	// the public key inherits kid, alg and use
+	if err := privateKey.Set(jwk.KeyIDKey, cfg.ID); err != nil {
+		return nil, nil, fmt.Errorf("could not set kid: %w", err)
+	}
+
+	if err := privateKey.Set(jwk.AlgorithmKey, alg); err != nil {
+		return nil, nil, fmt.Errorf("could not set alg: %w", err)
+	}
+
+	if err := privateKey.Set(jwk.KeyUsageKey, jwk.ForSignature); err != nil {
+		return nil, nil, fmt.Errorf("could not set use: %w", err)
+	}
+
+	publicKey, errK := jwk.PublicKeyOf(privateKey)
+	if errK != nil {
+		return nil, nil, fmt.Errorf("could not get public key: %w", errK)
+	}
+
+	return privateKey, publicKey, nil
+}
+
+// Encode signs the claims with the active key, its kid is set in the header.
+func (k *JWTKeys) Encode(claims map[string]any) (string, error) {
+	token := jwt.New()
+
+	for key, val := range claims {
+		if err := token.Set(key, val); err != nil {
+			return "", fmt.Errorf("could not set claim %s: %w", key, err)
+		}
+	}
+
+	signed, err := jwt.Sign(token, jwt.WithKey(k.signAlg, k.signKey))
+	if err != nil {
+		return "", fmt.Errorf("could not sign token: %w", err)
+	}
+
+	return string(signed), nil
+}
+
+// Decode verifies the signature and validates the claims of the token.
+func (k *JWTKeys) Decode(tokenString string) (jwt.Token, error) {
+	token, err := jwt.ParseString(tokenString, k.verifyOpt)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify token: %w", err)
+	}
+
+	return token, nil
+}
+
+// JWKSHandler serves the public keys, to be mounted on /.well-known/jwks.json.
+func (k *JWTKeys) JWKSHandler() http.HandlerFunc {
+	return func(respW http.ResponseWriter, _ *http.Request) {
+		jwks, err := json.Marshal(k.publicSet)
+		if err != nil {
+			http.Error(respW, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		respW.Header().Set("Content-Type", "application/json")
+		respW.Header().Set("Cache-Control", "public, max-age=300")
+
+		_, _ = respW.Write(jwks)
+	}
+}
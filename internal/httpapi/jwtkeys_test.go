@@ -0,0 +1,251 @@
+package httpapi
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+func writeTestPEM(t *testing.T, name string, key any) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("could not marshal key: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), name+".pem")
+
+	if err := os.WriteFile(
+		path,
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+		0o600,
+	); err != nil {
+		t.Fatalf("could not write key: %v", err)
+	}
+
+	return path
+}
+
+func newTestEd25519PEM(t *testing.T, name string) string {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+
+	return writeTestPEM(t, name, key)
+}
+
+func newTestRSAPEM(t *testing.T, name string) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd // test key size
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+
+	return writeTestPEM(t, name, key)
+}
+
+func testClaims() map[string]any {
+	return map[string]any{
+		jwt.SubjectKey:    "user",
+		jwt.ExpirationKey: time.Now().Add(time.Minute).Unix(),
+	}
+}
+
+func TestJWTKeys_Rotation(t *testing.T) {
+	t.Parallel()
+
+	oldPath := newTestRSAPEM(t, "old")
+	currentPath := newTestEd25519PEM(t, "current")
+	retiredPath := newTestEd25519PEM(t, "retired")
+
+	// the old key was active before the rotation
+	oldKeys, errO := NewJWTKeys([]JWTKeyConfig{
+		{ID: "old", Algorithm: "RS256", PrivateKeyPath: oldPath, Status: JWTKeyActive},
+	})
+	if errO != nil {
+		t.Fatalf("NewJWTKeys() error = %v", errO)
+	}
+
+	retiredKeys, errR := NewJWTKeys([]JWTKeyConfig{
+		{ID: "retired", Algorithm: "EdDSA", PrivateKeyPath: retiredPath, Status: JWTKeyActive},
+	})
+	if errR != nil {
+		t.Fatalf("NewJWTKeys() error = %v", errR)
+	}
+
+	keys, errN := NewJWTKeys([]JWTKeyConfig{
+		{ID: "current", Algorithm: "EdDSA", PrivateKeyPath: currentPath, Status: JWTKeyActive},
+		{ID: "old", Algorithm: "RS256", PrivateKeyPath: oldPath, Status: JWTKeyVerify},
+		{ID: "retired", Algorithm: "EdDSA", PrivateKeyPath: retiredPath, Status: JWTKeyRetired},
+	})
+	if errN != nil {
+		t.Fatalf("NewJWTKeys() error = %v", errN)
+	}
+
+	tests := []struct {
+		name    string
+		signer  *JWTKeys
+		wantErr bool
+	}{
+		{
+			name:    "active key",
+			signer:  keys,
+			wantErr: false,
+		},
+		{
+			name:    "verify key",
+			signer:  oldKeys,
+			wantErr: false,
+		},
+		{
+			name:    "retired key",
+			signer:  retiredKeys,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			signed, errE := tt.signer.Encode(testClaims())
+			if errE != nil {
+				t.Fatalf("JWTKeys.Encode() error = %v", errE)
+			}
+
+			token, err := keys.Decode(signed)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JWTKeys.Decode() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if !tt.wantErr && token.Subject() != "user" {
+				t.Errorf("JWTKeys.Decode() subject = %v, want user", token.Subject())
+			}
+		})
+	}
+}
+
+func TestNewJWTKeys(t *testing.T) {
+	t.Parallel()
+
+	path := newTestEd25519PEM(t, "key")
+
+	tests := []struct {
+		name    string
+		configs []JWTKeyConfig
+		wantErr error
+	}{
+		{
+			name: "no active key",
+			configs: []JWTKeyConfig{
+				{ID: "a", Algorithm: "EdDSA", PrivateKeyPath: path, Status: JWTKeyVerify},
+			},
+			wantErr: ErrNoActiveJWTKey,
+		},
+		{
+			name: "multiple active keys",
+			configs: []JWTKeyConfig{
+				{ID: "a", Algorithm: "EdDSA", PrivateKeyPath: path, Status: JWTKeyActive},
+				{ID: "b", Algorithm: "EdDSA", PrivateKeyPath: path, Status: JWTKeyActive},
+			},
+			wantErr: ErrMultipleActiveJWTKeys,
+		},
+		{
+			name: "symmetric algorithm",
+			configs: []JWTKeyConfig{
+				{ID: "a", Algorithm: "HS256", PrivateKeyPath: path, Status: JWTKeyActive},
+			},
+			wantErr: ErrUnsupportedJWTAlgorithm,
+		},
+		{
+			name: "unknown status",
+			configs: []JWTKeyConfig{
+				{ID: "a", Algorithm: "EdDSA", PrivateKeyPath: path, Status: "primary"},
+			},
+			wantErr: ErrUnknownJWTKeyStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := NewJWTKeys(tt.configs); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewJWTKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJWTKeys_JWKSHandler(t *testing.T) {
+	t.Parallel()
+
+	keys, errN := NewJWTKeys([]JWTKeyConfig{
+		{
+			ID:             "current",
+			Algorithm:      "EdDSA",
+			PrivateKeyPath: newTestEd25519PEM(t, "current"),
+			Status:         JWTKeyActive,
+		},
+		{
+			ID:             "old",
+			Algorithm:      "RS256",
+			PrivateKeyPath: newTestRSAPEM(t, "old"),
+			Status:         JWTKeyVerify,
+		},
+	})
+	if errN != nil {
+		t.Fatalf("NewJWTKeys() error = %v", errN)
+	}
+
+	rec := httptest.NewRecorder()
+	keys.JWKSHandler()(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("JWKSHandler() status = %v, want %v", rec.Code, http.StatusOK)
+	}
+
+	var jwks struct {
+		Keys []map[string]any `json:"keys"`
+	}
+
+	if err := json.NewDecoder(rec.Body).Decode(&jwks); err != nil {
+		t.Fatalf("could not decode jwks: %v", err)
+	}
+
+	if len(jwks.Keys) != 2 { //nolint:mnd // two published keys
+		t.Fatalf("JWKSHandler() keys = %v, want 2", len(jwks.Keys))
+	}
+
+	for _, key := range jwks.Keys {
+		// private parts of rsa and okp keys
+		for _, private := range []string{"d", "p", "q", "dp", "dq", "qi"} {
+			if _, ok := key[private]; ok {
+				t.Errorf("JWKSHandler() key %v exposes %s", key["kid"], private)
+			}
+		}
+
+		if key["use"] != "sig" || key["alg"] == nil {
+			t.Errorf("JWKSHandler() key %v missing use or alg", key["kid"])
+		}
+	}
+}
@@ -17,45 +17,97 @@ func fromDomainTags(tags []domain.Tag) []string {
 }
 
 func fromDomainArticle(art *domain.Article) Article {
-	return Article{
-		Slug:           art.Slug,
-		Title:          art.Title,
-		Description:    art.Description,
-		Body:           art.Body,
-		TagList:        fromDomainTags(art.TagList),
-		CreatedAt:      art.CreatedAt,
-		UpdatedAt:      art.UpdatedAt,
-		Favorited:      art.Favorited,
-		FavoritesCount: art.FavoritesCount,
-		Author:         FromDomainProfile(&art.Author),
+	article := Article{
+		Slug:            art.Slug,
+		Title:           art.Title,
+		Description:     art.Description,
+		Body:            art.Body,
+		TagList:         fromDomainTags(art.TagList),
+		CreatedAt:       art.CreatedAt,
+		UpdatedAt:       art.UpdatedAt,
+		Favorited:       art.Favorited,
+		FavoritesCount:  art.FavoritesCount,
+		CommentsCount:   art.CommentsCount,
+		Author:          FromDomainProfile(&art.Author),
+		Status:          ArticleStatus(art.Status),
+		PublishAt:       art.PublishAt,
+		WordCount:       art.WordCount,
+		ReadingTime:     art.ReadingTime,
+		TableOfContents: fromDomainTableOfContents(art.TableOfContents),
 	}
+
+	if art.BodyHTML != "" {
+		article.BodyHtml = &art.BodyHTML
+	}
+
+	return article
+}
+
+// versionedArticleResponse tags the article with the entity tag of its representation.
+func versionedArticleResponse(art *domain.Article) (VersionedArticleResponseJSONResponse, error) {
+	var resp VersionedArticleResponseJSONResponse
+
+	resp.Body.Article = fromDomainArticle(art)
+
+	etag, err := representationETag(art.Version, resp.Body)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Headers.ETag = etag
+
+	return resp, nil
 }
 
 func fromDomainArticleToArticleListItem(art *domain.Article) ArticleListItem {
 	return ArticleListItem{
-		Author:         FromDomainProfile(&art.Author),
-		CreatedAt:      art.CreatedAt,
-		Description:    art.Description,
-		Favorited:      art.Favorited,
-		FavoritesCount: art.FavoritesCount,
-		Slug:           art.Slug,
-		TagList:        fromDomainTags(art.TagList),
-		Title:          art.Title,
-		UpdatedAt:      art.UpdatedAt,
+		Author:          FromDomainProfile(&art.Author),
+		CreatedAt:       art.CreatedAt,
+		Description:     art.Description,
+		Favorited:       art.Favorited,
+		FavoritesCount:  art.FavoritesCount,
+		CommentsCount:   art.CommentsCount,
+		Slug:            art.Slug,
+		TagList:         fromDomainTags(art.TagList),
+		Title:           art.Title,
+		UpdatedAt:       art.UpdatedAt,
+		WordCount:       art.WordCount,
+		ReadingTime:     art.ReadingTime,
+		TableOfContents: fromDomainTableOfContents(art.TableOfContents),
+		DeletedAt:       art.DeletedAt,
 	}
 }
 
 // ArticleListItem matches the anonymous struct type in MultipleArticlesResponseJSONResponse.Articles
 type ArticleListItem = struct {
-	Author         Profile   `json:"author"`
-	CreatedAt      time.Time `json:"createdAt"`
-	Description    string    `json:"description"`
-	Favorited      bool      `json:"favorited"`
-	FavoritesCount int       `json:"favoritesCount"`
-	Slug           string    `json:"slug"`
-	TagList        []string  `json:"tagList"`
-	Title          string    `json:"title"`
-	UpdatedAt      time.Time `json:"updatedAt"`
+	Author          Profile                `json:"author"`
+	CommentsCount   int                    `json:"commentsCount"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	DeletedAt       *time.Time             `json:"deletedAt,omitempty"`
+	Description     string                 `json:"description"`
+	Favorited       bool                   `json:"favorited"`
+	FavoritesCount  int                    `json:"favoritesCount"`
+	ReadingTime     int                    `json:"readingTime"`
+	Slug            string                 `json:"slug"`
+	TableOfContents []TableOfContentsEntry `json:"tableOfContents"`
+	TagList         []string               `json:"tagList"`
+	Title           string                 `json:"title"`
+	UpdatedAt       time.Time              `json:"updatedAt"`
+	WordCount       int                    `json:"wordCount"`
+}
+
+func fromDomainTableOfContents(toc []domain.TOCEntry) []TableOfContentsEntry {
+	entries := make([]TableOfContentsEntry, len(toc))
+
+	for i, entry := range toc {
+		entries[i] = TableOfContentsEntry{
+			Level:  entry.Level,
+			Text:   entry.Text,
+			Anchor: entry.Anchor,
+		}
+	}
+
+	return entries
 }
 
 func fromDomainArticles(articles []*domain.Article) []ArticleListItem {
@@ -68,23 +120,102 @@ func fromDomainArticles(articles []*domain.Article) []ArticleListItem {
 	return articlesAPI
 }
 
+func fromDomainArticleSearchResult(res *domain.ArticleSearchResult) ArticleSearchResult {
+	return ArticleSearchResult{
+		Author:         FromDomainProfile(&res.Author),
+		CreatedAt:      res.CreatedAt,
+		Description:    res.Description,
+		Favorited:      res.Favorited,
+		FavoritesCount: res.FavoritesCount,
+		Slug:           res.Slug,
+		TagList:        fromDomainTags(res.TagList),
+		Title:          res.Title,
+		UpdatedAt:      res.UpdatedAt,
+		Rank:           res.Rank,
+		TitleHighlight: res.TitleHighlight,
+		Snippet:        res.Snippet,
+	}
+}
+
+func fromDomainArticleSearchResults(results []*domain.ArticleSearchResult) []ArticleSearchResult {
+	resultsAPI := make([]ArticleSearchResult, len(results))
+
+	for i, r := range results {
+		resultsAPI[i] = fromDomainArticleSearchResult(r)
+	}
+
+	return resultsAPI
+}
+
+func fromDomainArticleRevision(rev *domain.ArticleRevision) ArticleRevision {
+	revision := ArticleRevision{
+		Number:      rev.Number,
+		Title:       rev.Title,
+		Description: rev.Description,
+		Body:        rev.Body,
+		CreatedAt:   rev.CreatedAt,
+	}
+
+	// the editor is gone once deleted
+	if rev.Editor != nil {
+		editor := FromDomainProfile(rev.Editor)
+		revision.Editor = &editor
+	}
+
+	return revision
+}
+
+func fromDomainArticleRevisions(revisions []*domain.ArticleRevision) []ArticleRevision {
+	revisionsAPI := make([]ArticleRevision, len(revisions))
+
+	for i, r := range revisions {
+		revisionsAPI[i] = fromDomainArticleRevision(r)
+	}
+
+	return revisionsAPI
+}
+
 func FromDomainProfile(p *domain.Profile) Profile {
 	return Profile{
-		Username:  p.Username,
-		Bio:       p.Bio,
-		Image:     p.Image,
-		Following: p.Following,
+		Username:       p.Username,
+		Bio:            p.Bio,
+		Image:          p.Image,
+		Following:      p.Following,
+		FollowersCount: p.FollowersCount,
+		FollowingCount: p.FollowingCount,
+		ArticlesCount:  p.ArticlesCount,
+	}
+}
+
+func fromDomainFollows(follows []*domain.Follow) []Profile {
+	profiles := make([]Profile, len(follows))
+
+	for i, f := range follows {
+		profiles[i] = FromDomainProfile(&f.Profile)
 	}
+
+	return profiles
 }
 
 func fromDomainComment(cmt *domain.Comment) Comment {
-	return Comment{
+	comment := Comment{
 		Id:        cmt.ID,
+		ParentId:  cmt.ParentID,
+		Depth:     cmt.Depth,
 		CreatedAt: cmt.CreatedAt,
 		UpdatedAt: cmt.UpdatedAt,
 		Body:      cmt.Body,
-		Author:    FromDomainProfile(&cmt.Author),
+		Deleted:   cmt.Deleted,
+		Edited:    cmt.Edited,
 	}
+
+	// the placeholder of a deleted comment has no author
+	if !cmt.Deleted {
+		author := FromDomainProfile(&cmt.Author)
+		comment.Author = &author
+	}
+
+	return comment
 }
 
 func fromDomainComments(comments []*domain.Comment) []Comment {
@@ -106,3 +237,89 @@ func fromDomainUser(user *domain.User, token string) User {
 		Image:    user.Image,
 	}
 }
+
+// versionedUserResponse tags the user with the entity tag of its representation,
+// which includes the token.
+func versionedUserResponse(user User, version int) (VersionedUserResponseJSONResponse, error) {
+	var resp VersionedUserResponseJSONResponse
+
+	resp.Body.User = user
+
+	etag, err := representationETag(version, resp.Body)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Headers.ETag = etag
+
+	return resp, nil
+}
+
+func fromDomainPersonalAccessToken(pat *domain.PersonalAccessToken) PersonalAccessToken {
+	return PersonalAccessToken{
+		Id:        pat.ID,
+		Name:      pat.Name,
+		Scopes:    pat.Scopes,
+		ExpiresAt: pat.ExpiresAt,
+		CreatedAt: pat.CreatedAt,
+	}
+}
+
+func fromDomainPersonalAccessTokens(tokens []*domain.PersonalAccessToken) []PersonalAccessToken {
+	tokensAPI := make([]PersonalAccessToken, len(tokens))
+
+	for i, t := range tokens {
+		tokensAPI[i] = fromDomainPersonalAccessToken(t)
+	}
+
+	return tokensAPI
+}
+
+// fromDomainNextCursor omits the cursor of the last page.
+func fromDomainNextCursor(cursor string) *NextCursor {
+	if cursor == "" {
+		return nil
+	}
+
+	return &cursor
+}
+
+func toDomainArticleFilter(params GetArticlesParams) domain.ArticleFilter {
+	filter := domain.ArticleFilter{
+		Favorited:     params.Favorited,
+		CreatedAfter:  params.CreatedAfter,
+		CreatedBefore: params.CreatedBefore,
+		UpdatedAfter:  params.UpdatedAfter,
+		UpdatedBefore: params.UpdatedBefore,
+	}
+
+	if params.Tag != nil {
+		filter.Tags = *params.Tag
+	}
+
+	if params.TagMatch != nil {
+		filter.TagMatch = domain.TagMatch(*params.TagMatch)
+	}
+
+	if params.ExcludeTag != nil {
+		filter.ExcludedTags = *params.ExcludeTag
+	}
+
+	if params.Author != nil {
+		filter.Authors = *params.Author
+	}
+
+	if params.Sort != nil {
+		filter.Sort = domain.ArticleSort(*params.Sort)
+	}
+
+	return filter
+}
+
+func toDomainCommentSort(sort *GetArticleCommentsParamsSort) domain.CommentSort {
+	if sort == nil {
+		return ""
+	}
+
+	return domain.CommentSort(*sort)
+}
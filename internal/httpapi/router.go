@@ -9,7 +9,6 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
-	"github.com/go-chi/jwtauth/v5"
 	"github.com/go-chi/render"
 	"github.com/induzo/gocom/http/middleware/writablecontext"
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
@@ -22,7 +21,9 @@ func CreateRouter(
 	svc domain.APIService,
 	logger *slog.Logger,
 	isDebug bool,
-	jwtSecret string,
+	keys *JWTKeys,
+	accessTokenTTL time.Duration,
+	legacyErrorFormat bool,
 ) (*chi.Mux, error) {
 	// create chi router
 	rtr := chi.NewRouter()
@@ -38,6 +39,9 @@ func CreateRouter(
 		)
 	}
 
+	// public keys, so that other services can verify our tokens
+	rtr.Get("/.well-known/jwks.json", keys.JWKSHandler())
+
 	// normal handlers/operations, with json and timeout
 	rtr.Group(func(rtr chi.Router) {
 		const endpointTimeout = 60 * time.Second
@@ -64,17 +68,25 @@ func CreateRouter(
 
 		oapiRouter := chi.NewRouter()
 
-		jwtA := jwtauth.New("HS256", []byte(jwtSecret), nil)
+		errW := newErrorWriter(logger, legacyErrorFormat)
 
 		// Create an instance of our handler which satisfies the generated interface
-		oapiServerStrictHandler := NewStrictHandler(NewStrictAPIServer(svc, jwtA), nil)
+		oapiServerStrictHandler := NewStrictHandlerWithOptions(
+			NewStrictAPIServer(svc, keys, accessTokenTTL),
+			nil,
+			StrictHTTPServerOptions{
+				RequestErrorHandlerFunc:  errW.RequestError,
+				ResponseErrorHandlerFunc: errW.ResponseError,
+			},
+		)
 
 		rtr.Use(
 			oapimiddleware.OapiRequestValidatorWithOptions(
 				swagger,
 				&oapimiddleware.Options{
+					ErrorHandlerWithOpts: errW.ValidationError,
 					Options: openapi3filter.Options{
-						AuthenticationFunc: NewAuthenticator(jwtA),
+						AuthenticationFunc: NewAuthenticator(keys, svc),
 					},
 				},
 			),
@@ -0,0 +1,528 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"realworld/internal/domain"
+)
+
+// stubService serves a draft of its author through the router, the author follows
+// every listed profile and favorites every article found.
+// The methods it does not override panic on the nil embedded service.
+type stubService struct {
+	domain.APIService
+
+	authorID uuid.UUID
+	draft    *domain.Article
+}
+
+func (stubService) IsTokenRevoked(_ context.Context, _, _ uuid.UUID, _ time.Time) (bool, error) {
+	return false, nil
+}
+
+func (s stubService) GetArticle(_ context.Context, userID uuid.UUID, slug string) (*domain.Article, error) {
+	if slug != s.draft.Slug || userID != s.authorID {
+		return nil, fmt.Errorf("stub: %w", domain.ErrNotFound)
+	}
+
+	return s.draft, nil
+}
+
+func (s stubService) GetDraftArticles(
+	_ context.Context,
+	userID uuid.UUID,
+	_, _ *int,
+) (*domain.ArticlePage, error) {
+	if userID != s.authorID {
+		return &domain.ArticlePage{}, nil
+	}
+
+	return &domain.ArticlePage{Articles: []*domain.Article{s.draft}, Total: 1}, nil
+}
+
+func (s stubService) GetFollowers(
+	_ context.Context,
+	userID uuid.UUID,
+	_ string,
+	_ *int,
+	_ *string,
+) (*domain.FollowPage, error) {
+	return s.follows(userID), nil
+}
+
+func (s stubService) GetFollowing(
+	_ context.Context,
+	userID uuid.UUID,
+	_ string,
+	_ *int,
+	_ *string,
+) (*domain.FollowPage, error) {
+	return s.follows(userID), nil
+}
+
+func (s stubService) follows(userID uuid.UUID) *domain.FollowPage {
+	return &domain.FollowPage{
+		Follows: []*domain.Follow{{
+			Profile: domain.Profile{Username: "jake", Following: userID == s.authorID},
+			UserID:  uuid.Must(uuid.NewV7()),
+		}},
+	}
+}
+
+func (s stubService) SearchArticles(
+	_ context.Context,
+	userID uuid.UUID,
+	_ string,
+	_, _ *int,
+) (*domain.ArticleSearchPage, error) {
+	viewed := userID == s.authorID
+
+	return &domain.ArticleSearchPage{
+		Results: []*domain.ArticleSearchResult{{
+			Article: domain.Article{
+				Slug:      "dragons",
+				Favorited: viewed,
+				Author:    domain.Profile{Username: "jake", Following: viewed},
+			},
+		}},
+		Total: 1,
+	}, nil
+}
+
+func (s stubService) UpdateUser(
+	_ context.Context,
+	userID uuid.UUID,
+	_, _, _, bio, _ *string,
+	_ []int,
+) (*domain.User, error) {
+	user := &domain.User{ID: userID, Username: "jake", Version: 2}
+	if bio != nil {
+		user.Bio = *bio
+	}
+
+	return user, nil
+}
+
+func (s stubService) RestoreArticleRevision(
+	_ context.Context,
+	_ uuid.UUID,
+	slug string,
+	_ int,
+	expectedVersions []int,
+) (*domain.Article, error) {
+	if slug != s.draft.Slug {
+		return nil, fmt.Errorf("stub: %w", domain.ErrNotFound)
+	}
+
+	if expectedVersions != nil && !slices.Contains(expectedVersions, s.draft.Version) {
+		return nil, fmt.Errorf("stub: %w", domain.ErrVersionMismatch)
+	}
+
+	restored := *s.draft
+	restored.Version++
+
+	return &restored, nil
+}
+
+func newTestRouter(t *testing.T, svc stubService) (*chi.Mux, *StrictAPIServer) {
+	t.Helper()
+
+	keys, errK := NewHMACJWTKeys("secret")
+	if errK != nil {
+		t.Fatalf("NewHMACJWTKeys() error = %v", errK)
+	}
+
+	rtr, errR := CreateRouter(
+		t.Context(),
+		svc,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		false,
+		keys,
+		time.Minute,
+		false,
+	)
+	if errR != nil {
+		t.Fatalf("CreateRouter() error = %v", errR)
+	}
+
+	return rtr, NewStrictAPIServer(svc, keys, time.Minute)
+}
+
+func TestRouter_Drafts(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.Must(uuid.NewV7())
+	svc := stubService{
+		authorID: authorID,
+		draft: &domain.Article{
+			Slug:    "wip",
+			Title:   "Work in progress",
+			Version: 1,
+			Status:  domain.ArticleStatusDraft,
+		},
+	}
+
+	rtr, srv := newTestRouter(t, svc)
+
+	authorToken, errA := srv.encodeAccessToken(authorID, uuid.Must(uuid.NewV7()))
+	if errA != nil {
+		t.Fatalf("could not encode token: %v", errA)
+	}
+
+	readerToken, errR := srv.encodeAccessToken(uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()))
+	if errR != nil {
+		t.Fatalf("could not encode token: %v", errR)
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		token      string
+		wantStatus int
+		wantCount  int
+	}{
+		{
+			name:       "author reads the draft",
+			path:       "/articles/wip",
+			token:      authorToken,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "reader can't read the draft",
+			path:       "/articles/wip",
+			token:      readerToken,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "anonymous can't read the draft",
+			path:       "/articles/wip",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "invalid token is served as anonymous",
+			path:       "/articles/wip",
+			token:      "not.a.jwt",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "author lists the drafts",
+			path:       "/user/drafts",
+			token:      authorToken,
+			wantStatus: http.StatusOK,
+			wantCount:  1,
+		},
+		{
+			name:       "reader has no drafts",
+			path:       "/user/drafts",
+			token:      readerToken,
+			wantStatus: http.StatusOK,
+			wantCount:  0,
+		},
+		{
+			name:       "drafts require auth",
+			path:       "/user/drafts",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Token "+tt.token)
+			}
+
+			rec := httptest.NewRecorder()
+			rtr.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("GET %s status = %v, want %v: %s", tt.path, rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var body struct {
+				Article       *Article `json:"article"`
+				ArticlesCount *int     `json:"articlesCount"`
+			}
+			if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode body: %v", err)
+			}
+
+			switch {
+			case body.ArticlesCount != nil:
+				if *body.ArticlesCount != tt.wantCount {
+					t.Errorf("GET %s articlesCount = %v, want %v", tt.path, *body.ArticlesCount, tt.wantCount)
+				}
+			case body.Article == nil || body.Article.Status != Draft:
+				t.Errorf("GET %s article = %+v, want the draft", tt.path, body.Article)
+			}
+		})
+	}
+}
+
+func TestRouter_Follows(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.Must(uuid.NewV7())
+	rtr, srv := newTestRouter(t, stubService{authorID: authorID})
+
+	authorToken, errA := srv.encodeAccessToken(authorID, uuid.Must(uuid.NewV7()))
+	if errA != nil {
+		t.Fatalf("could not encode token: %v", errA)
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		token         string
+		wantFollowing bool
+	}{
+		{
+			name:          "followers seen by the viewer",
+			path:          "/profiles/celeb/followers",
+			token:         authorToken,
+			wantFollowing: true,
+		},
+		{
+			name:          "following seen by the viewer",
+			path:          "/profiles/celeb/following",
+			token:         authorToken,
+			wantFollowing: true,
+		},
+		{
+			name:          "followers seen by anonymous",
+			path:          "/profiles/celeb/followers",
+			wantFollowing: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Token "+tt.token)
+			}
+
+			rec := httptest.NewRecorder()
+			rtr.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("GET %s status = %v, want %v: %s", tt.path, rec.Code, http.StatusOK, rec.Body)
+			}
+
+			var body MultipleProfilesResponseJSONResponse
+			if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode body: %v", err)
+			}
+
+			if len(body.Profiles) != 1 || body.Profiles[0].Following != tt.wantFollowing {
+				t.Errorf("GET %s profiles = %+v, want following %v", tt.path, body.Profiles, tt.wantFollowing)
+			}
+		})
+	}
+}
+
+func TestRouter_SearchArticles(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.Must(uuid.NewV7())
+	rtr, srv := newTestRouter(t, stubService{authorID: authorID})
+
+	authorToken, errA := srv.encodeAccessToken(authorID, uuid.Must(uuid.NewV7()))
+	if errA != nil {
+		t.Fatalf("could not encode token: %v", errA)
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		wantViewer bool
+	}{
+		{
+			name:       "search seen by the viewer",
+			token:      authorToken,
+			wantViewer: true,
+		},
+		{
+			name:       "search seen by anonymous",
+			wantViewer: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/articles/search?q=dragon", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Token "+tt.token)
+			}
+
+			rec := httptest.NewRecorder()
+			rtr.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("GET /articles/search status = %v, want %v: %s", rec.Code, http.StatusOK, rec.Body)
+			}
+
+			var body ArticleSearchResponseJSONResponse
+			if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode body: %v", err)
+			}
+
+			if len(body.Articles) != 1 || body.Articles[0].Favorited != tt.wantViewer ||
+				body.Articles[0].Author.Following != tt.wantViewer {
+				t.Errorf("GET /articles/search articles = %+v, want favorited and following %v", body.Articles, tt.wantViewer)
+			}
+		})
+	}
+}
+
+func TestRouter_UpdateCurrentUser_PersonalAccessToken(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.Must(uuid.NewV7())
+	rtr, srv := newTestRouter(t, stubService{authorID: authorID})
+
+	pat, errN := domain.NewPersonalAccessToken(authorID, "ci bot", []string{domain.ScopeUserWrite}, nil)
+	if errN != nil {
+		t.Fatalf("domain.NewPersonalAccessToken() error = %v", errN)
+	}
+
+	token, errE := srv.encodePersonalAccessToken(pat)
+	if errE != nil {
+		t.Fatalf("could not encode token: %v", errE)
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "password change is refused",
+			body:       `{"user":{"password":"hijacked"}}`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "email change is refused",
+			body:       `{"user":{"email":"attacker@evil.com"}}`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "bio change is allowed",
+			body:       `{"user":{"bio":"beep boop"}}`,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPut, "/user", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Token "+token)
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			rtr.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("PUT /user status = %v, want %v: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var body VersionedUserResponseJSONResponse
+			if err := json.NewDecoder(rec.Body).Decode(&body.Body); err != nil {
+				t.Fatalf("could not decode body: %v", err)
+			}
+
+			// the personal access token is handed back, no session is issued
+			if body.Body.User.Token != token || body.Body.User.RefreshToken != nil {
+				t.Errorf("PUT /user user = %+v, want the personal access token only", body.Body.User)
+			}
+		})
+	}
+}
+
+func TestRouter_RestoreArticleRevision(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.Must(uuid.NewV7())
+	rtr, srv := newTestRouter(t, stubService{
+		authorID: authorID,
+		draft:    &domain.Article{Slug: "wip", Title: "Work in progress", Version: 3},
+	})
+
+	token, errE := srv.encodeAccessToken(authorID, uuid.Must(uuid.NewV7()))
+	if errE != nil {
+		t.Fatalf("could not encode token: %v", errE)
+	}
+
+	tests := []struct {
+		name       string
+		ifMatch    string
+		wantStatus int
+	}{
+		{
+			name:       "without If-Match",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "current version",
+			ifMatch:    `"3-0123456789abcdef"`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "stale version",
+			ifMatch:    `"2-0123456789abcdef"`,
+			wantStatus: http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/articles/wip/revisions/1/restore", nil)
+			req.Header.Set("Authorization", "Token "+token)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			rec := httptest.NewRecorder()
+			rtr.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("POST restore status = %v, want %v: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			if rec.Code == http.StatusOK && !strings.HasPrefix(rec.Header().Get("ETag"), `"4-`) {
+				t.Errorf("POST restore ETag = %v, want the restored version", rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
@@ -33,30 +34,60 @@ type ServerInterface interface {
 	// Get recent articles from users you follow
 	// (GET /articles/feed)
 	GetArticlesFeed(w http.ResponseWriter, r *http.Request, params GetArticlesFeedParams)
+	// Search articles
+	// (GET /articles/search)
+	SearchArticles(w http.ResponseWriter, r *http.Request, params SearchArticlesParams)
 	// Delete an article
 	// (DELETE /articles/{slug})
 	DeleteArticle(w http.ResponseWriter, r *http.Request, slug string)
 	// Get an article
 	// (GET /articles/{slug})
-	GetArticle(w http.ResponseWriter, r *http.Request, slug string)
+	GetArticle(w http.ResponseWriter, r *http.Request, slug string, params GetArticleParams)
 	// Update an article
 	// (PUT /articles/{slug})
-	UpdateArticle(w http.ResponseWriter, r *http.Request, slug string)
+	UpdateArticle(w http.ResponseWriter, r *http.Request, slug string, params UpdateArticleParams)
 	// Get comments for an article
 	// (GET /articles/{slug}/comments)
-	GetArticleComments(w http.ResponseWriter, r *http.Request, slug string)
+	GetArticleComments(w http.ResponseWriter, r *http.Request, slug string, params GetArticleCommentsParams)
 	// Create a comment for an article
 	// (POST /articles/{slug}/comments)
 	CreateArticleComment(w http.ResponseWriter, r *http.Request, slug string)
 	// Delete a comment for an article
 	// (DELETE /articles/{slug}/comments/{id})
 	DeleteArticleComment(w http.ResponseWriter, r *http.Request, slug string, id int)
+	// Update a comment for an article
+	// (PUT /articles/{slug}/comments/{id})
+	UpdateArticleComment(w http.ResponseWriter, r *http.Request, slug string, id int)
 	// Unfavorite an article
 	// (DELETE /articles/{slug}/favorite)
 	DeleteArticleFavorite(w http.ResponseWriter, r *http.Request, slug string)
 	// Favorite an article
 	// (POST /articles/{slug}/favorite)
 	CreateArticleFavorite(w http.ResponseWriter, r *http.Request, slug string)
+	// Unpublish an article
+	// (DELETE /articles/{slug}/publish)
+	UnpublishArticle(w http.ResponseWriter, r *http.Request, slug string)
+	// Publish an article
+	// (POST /articles/{slug}/publish)
+	PublishArticle(w http.ResponseWriter, r *http.Request, slug string, params PublishArticleParams)
+	// Restore a trashed article
+	// (POST /articles/{slug}/restore)
+	RestoreArticle(w http.ResponseWriter, r *http.Request, slug string)
+	// Get the revisions of an article
+	// (GET /articles/{slug}/revisions)
+	GetArticleRevisions(w http.ResponseWriter, r *http.Request, slug string)
+	// Get a revision of an article
+	// (GET /articles/{slug}/revisions/{number})
+	GetArticleRevision(w http.ResponseWriter, r *http.Request, slug string, number int)
+	// Diff two revisions of an article
+	// (GET /articles/{slug}/revisions/{number}/diff)
+	GetArticleRevisionDiff(w http.ResponseWriter, r *http.Request, slug string, number int, params GetArticleRevisionDiffParams)
+	// Restore a revision of an article
+	// (POST /articles/{slug}/revisions/{number}/restore)
+	RestoreArticleRevision(w http.ResponseWriter, r *http.Request, slug string, number int, params RestoreArticleRevisionParams)
+	// Preview markdown
+	// (POST /markdown/preview)
+	PreviewMarkdown(w http.ResponseWriter, r *http.Request)
 	// Get a profile
 	// (GET /profiles/{username})
 	GetProfileByUsername(w http.ResponseWriter, r *http.Request, username string)
@@ -66,21 +97,51 @@ type ServerInterface interface {
 	// Follow a user
 	// (POST /profiles/{username}/follow)
 	FollowUserByUsername(w http.ResponseWriter, r *http.Request, username string)
+	// Get the followers of a user
+	// (GET /profiles/{username}/followers)
+	GetProfileFollowers(w http.ResponseWriter, r *http.Request, username string, params GetProfileFollowersParams)
+	// Get the users a user follows
+	// (GET /profiles/{username}/following)
+	GetProfileFollowing(w http.ResponseWriter, r *http.Request, username string, params GetProfileFollowingParams)
 	// Get tags
 	// (GET /tags)
 	GetTags(w http.ResponseWriter, r *http.Request)
 	// Get current user
 	// (GET /user)
-	GetCurrentUser(w http.ResponseWriter, r *http.Request)
+	GetCurrentUser(w http.ResponseWriter, r *http.Request, params GetCurrentUserParams)
 	// Update current user
 	// (PUT /user)
-	UpdateCurrentUser(w http.ResponseWriter, r *http.Request)
+	UpdateCurrentUser(w http.ResponseWriter, r *http.Request, params UpdateCurrentUserParams)
+	// Get the drafts
+	// (GET /user/drafts)
+	GetDraftArticles(w http.ResponseWriter, r *http.Request, params GetDraftArticlesParams)
+	// Log out
+	// (POST /user/logout)
+	Logout(w http.ResponseWriter, r *http.Request)
+	// Log out everywhere
+	// (POST /user/logout/all)
+	LogoutAll(w http.ResponseWriter, r *http.Request)
+	// List personal access tokens
+	// (GET /user/tokens)
+	GetPersonalAccessTokens(w http.ResponseWriter, r *http.Request)
+	// Create a personal access token
+	// (POST /user/tokens)
+	CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request)
+	// Revoke a personal access token
+	// (DELETE /user/tokens/{id})
+	DeletePersonalAccessToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the trashed articles
+	// (GET /user/trash)
+	GetTrashedArticles(w http.ResponseWriter, r *http.Request, params GetTrashedArticlesParams)
 
 	// (POST /users)
 	CreateUser(w http.ResponseWriter, r *http.Request)
 	// Existing user login
 	// (POST /users/login)
 	Login(w http.ResponseWriter, r *http.Request)
+	// Refresh the access token
+	// (POST /users/token/refresh)
+	RefreshToken(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -105,6 +166,12 @@ func (_ Unimplemented) GetArticlesFeed(w http.ResponseWriter, r *http.Request, p
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Search articles
+// (GET /articles/search)
+func (_ Unimplemented) SearchArticles(w http.ResponseWriter, r *http.Request, params SearchArticlesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete an article
 // (DELETE /articles/{slug})
 func (_ Unimplemented) DeleteArticle(w http.ResponseWriter, r *http.Request, slug string) {
@@ -113,19 +180,19 @@ func (_ Unimplemented) DeleteArticle(w http.ResponseWriter, r *http.Request, slu
 
 // Get an article
 // (GET /articles/{slug})
-func (_ Unimplemented) GetArticle(w http.ResponseWriter, r *http.Request, slug string) {
+func (_ Unimplemented) GetArticle(w http.ResponseWriter, r *http.Request, slug string, params GetArticleParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Update an article
 // (PUT /articles/{slug})
-func (_ Unimplemented) UpdateArticle(w http.ResponseWriter, r *http.Request, slug string) {
+func (_ Unimplemented) UpdateArticle(w http.ResponseWriter, r *http.Request, slug string, params UpdateArticleParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Get comments for an article
 // (GET /articles/{slug}/comments)
-func (_ Unimplemented) GetArticleComments(w http.ResponseWriter, r *http.Request, slug string) {
+func (_ Unimplemented) GetArticleComments(w http.ResponseWriter, r *http.Request, slug string, params GetArticleCommentsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -141,6 +208,12 @@ func (_ Unimplemented) DeleteArticleComment(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update a comment for an article
+// (PUT /articles/{slug}/comments/{id})
+func (_ Unimplemented) UpdateArticleComment(w http.ResponseWriter, r *http.Request, slug string, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Unfavorite an article
 // (DELETE /articles/{slug}/favorite)
 func (_ Unimplemented) DeleteArticleFavorite(w http.ResponseWriter, r *http.Request, slug string) {
@@ -153,6 +226,54 @@ func (_ Unimplemented) CreateArticleFavorite(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Unpublish an article
+// (DELETE /articles/{slug}/publish)
+func (_ Unimplemented) UnpublishArticle(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Publish an article
+// (POST /articles/{slug}/publish)
+func (_ Unimplemented) PublishArticle(w http.ResponseWriter, r *http.Request, slug string, params PublishArticleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a trashed article
+// (POST /articles/{slug}/restore)
+func (_ Unimplemented) RestoreArticle(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the revisions of an article
+// (GET /articles/{slug}/revisions)
+func (_ Unimplemented) GetArticleRevisions(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a revision of an article
+// (GET /articles/{slug}/revisions/{number})
+func (_ Unimplemented) GetArticleRevision(w http.ResponseWriter, r *http.Request, slug string, number int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Diff two revisions of an article
+// (GET /articles/{slug}/revisions/{number}/diff)
+func (_ Unimplemented) GetArticleRevisionDiff(w http.ResponseWriter, r *http.Request, slug string, number int, params GetArticleRevisionDiffParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a revision of an article
+// (POST /articles/{slug}/revisions/{number}/restore)
+func (_ Unimplemented) RestoreArticleRevision(w http.ResponseWriter, r *http.Request, slug string, number int, params RestoreArticleRevisionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview markdown
+// (POST /markdown/preview)
+func (_ Unimplemented) PreviewMarkdown(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get a profile
 // (GET /profiles/{username})
 func (_ Unimplemented) GetProfileByUsername(w http.ResponseWriter, r *http.Request, username string) {
@@ -171,6 +292,18 @@ func (_ Unimplemented) FollowUserByUsername(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the followers of a user
+// (GET /profiles/{username}/followers)
+func (_ Unimplemented) GetProfileFollowers(w http.ResponseWriter, r *http.Request, username string, params GetProfileFollowersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the users a user follows
+// (GET /profiles/{username}/following)
+func (_ Unimplemented) GetProfileFollowing(w http.ResponseWriter, r *http.Request, username string, params GetProfileFollowingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get tags
 // (GET /tags)
 func (_ Unimplemented) GetTags(w http.ResponseWriter, r *http.Request) {
@@ -179,13 +312,55 @@ func (_ Unimplemented) GetTags(w http.ResponseWriter, r *http.Request) {
 
 // Get current user
 // (GET /user)
-func (_ Unimplemented) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GetCurrentUser(w http.ResponseWriter, r *http.Request, params GetCurrentUserParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Update current user
 // (PUT /user)
-func (_ Unimplemented) UpdateCurrentUser(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) UpdateCurrentUser(w http.ResponseWriter, r *http.Request, params UpdateCurrentUserParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the drafts
+// (GET /user/drafts)
+func (_ Unimplemented) GetDraftArticles(w http.ResponseWriter, r *http.Request, params GetDraftArticlesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log out
+// (POST /user/logout)
+func (_ Unimplemented) Logout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log out everywhere
+// (POST /user/logout/all)
+func (_ Unimplemented) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List personal access tokens
+// (GET /user/tokens)
+func (_ Unimplemented) GetPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a personal access token
+// (POST /user/tokens)
+func (_ Unimplemented) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a personal access token
+// (DELETE /user/tokens/{id})
+func (_ Unimplemented) DeletePersonalAccessToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the trashed articles
+// (GET /user/trash)
+func (_ Unimplemented) GetTrashedArticles(w http.ResponseWriter, r *http.Request, params GetTrashedArticlesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -200,6 +375,12 @@ func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Refresh the access token
+// (POST /users/token/refresh)
+func (_ Unimplemented) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -225,6 +406,22 @@ func (siw *ServerInterfaceWrapper) GetArticles(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Optional query parameter "tagMatch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagMatch", r.URL.Query(), &params.TagMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagMatch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "excludeTag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeTag", r.URL.Query(), &params.ExcludeTag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "excludeTag", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "author" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "author", r.URL.Query(), &params.Author)
@@ -241,6 +438,46 @@ func (siw *ServerInterfaceWrapper) GetArticles(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updatedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedAfter", r.URL.Query(), &params.UpdatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updatedAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updatedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedBefore", r.URL.Query(), &params.UpdatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updatedBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
@@ -257,6 +494,14 @@ func (siw *ServerInterfaceWrapper) GetArticles(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetArticles(w, r, params)
 	}))
@@ -273,7 +518,7 @@ func (siw *ServerInterfaceWrapper) CreateArticle(w http.ResponseWriter, r *http.
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
 
 	r = r.WithContext(ctx)
 
@@ -318,6 +563,14 @@ func (siw *ServerInterfaceWrapper) GetArticlesFeed(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetArticlesFeed(w, r, params)
 	}))
@@ -329,6 +582,62 @@ func (siw *ServerInterfaceWrapper) GetArticlesFeed(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// SearchArticles operation middleware
+func (siw *ServerInterfaceWrapper) SearchArticles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchArticlesParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchArticles(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteArticle operation middleware
 func (siw *ServerInterfaceWrapper) DeleteArticle(w http.ResponseWriter, r *http.Request) {
 
@@ -345,7 +654,7 @@ func (siw *ServerInterfaceWrapper) DeleteArticle(w http.ResponseWriter, r *http.
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
 
 	r = r.WithContext(ctx)
 
@@ -374,8 +683,38 @@ func (siw *ServerInterfaceWrapper) GetArticle(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArticleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArticle(w, r, slug)
+		siw.Handler.GetArticle(w, r, slug, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -401,12 +740,36 @@ func (siw *ServerInterfaceWrapper) UpdateArticle(w http.ResponseWriter, r *http.
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateArticleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateArticle(w, r, slug)
+		siw.Handler.UpdateArticle(w, r, slug, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -430,8 +793,49 @@ func (siw *ServerInterfaceWrapper) GetArticleComments(w http.ResponseWriter, r *
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArticleCommentsParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArticleComments(w, r, slug)
+		siw.Handler.GetArticleComments(w, r, slug, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -457,7 +861,7 @@ func (siw *ServerInterfaceWrapper) CreateArticleComment(w http.ResponseWriter, r
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"comments:write"})
 
 	r = r.WithContext(ctx)
 
@@ -497,7 +901,7 @@ func (siw *ServerInterfaceWrapper) DeleteArticleComment(w http.ResponseWriter, r
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"comments:write"})
 
 	r = r.WithContext(ctx)
 
@@ -512,8 +916,8 @@ func (siw *ServerInterfaceWrapper) DeleteArticleComment(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteArticleFavorite operation middleware
-func (siw *ServerInterfaceWrapper) DeleteArticleFavorite(w http.ResponseWriter, r *http.Request) {
+// UpdateArticleComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateArticleComment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -526,14 +930,23 @@ func (siw *ServerInterfaceWrapper) DeleteArticleFavorite(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"comments:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteArticleFavorite(w, r, slug)
+		siw.Handler.UpdateArticleComment(w, r, slug, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -543,8 +956,8 @@ func (siw *ServerInterfaceWrapper) DeleteArticleFavorite(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// CreateArticleFavorite operation middleware
-func (siw *ServerInterfaceWrapper) CreateArticleFavorite(w http.ResponseWriter, r *http.Request) {
+// DeleteArticleFavorite operation middleware
+func (siw *ServerInterfaceWrapper) DeleteArticleFavorite(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -559,12 +972,12 @@ func (siw *ServerInterfaceWrapper) CreateArticleFavorite(w http.ResponseWriter,
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateArticleFavorite(w, r, slug)
+		siw.Handler.DeleteArticleFavorite(w, r, slug)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -574,22 +987,28 @@ func (siw *ServerInterfaceWrapper) CreateArticleFavorite(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetProfileByUsername operation middleware
-func (siw *ServerInterfaceWrapper) GetProfileByUsername(w http.ResponseWriter, r *http.Request) {
+// CreateArticleFavorite operation middleware
+func (siw *ServerInterfaceWrapper) CreateArticleFavorite(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "slug" -------------
+	var slug string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProfileByUsername(w, r, username)
+		siw.Handler.CreateArticleFavorite(w, r, slug)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -599,28 +1018,28 @@ func (siw *ServerInterfaceWrapper) GetProfileByUsername(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// UnfollowUserByUsername operation middleware
-func (siw *ServerInterfaceWrapper) UnfollowUserByUsername(w http.ResponseWriter, r *http.Request) {
+// UnpublishArticle operation middleware
+func (siw *ServerInterfaceWrapper) UnpublishArticle(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "slug" -------------
+	var slug string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UnfollowUserByUsername(w, r, username)
+		siw.Handler.UnpublishArticle(w, r, slug)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -630,28 +1049,39 @@ func (siw *ServerInterfaceWrapper) UnfollowUserByUsername(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// FollowUserByUsername operation middleware
-func (siw *ServerInterfaceWrapper) FollowUserByUsername(w http.ResponseWriter, r *http.Request) {
+// PublishArticle operation middleware
+func (siw *ServerInterfaceWrapper) PublishArticle(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "slug" -------------
+	var slug string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, TokenScopes, []string{})
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PublishArticleParams
+
+	// ------------- Optional query parameter "publishAt" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "publishAt", r.URL.Query(), &params.PublishAt)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "publishAt", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FollowUserByUsername(w, r, username)
+		siw.Handler.PublishArticle(w, r, slug, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -661,11 +1091,28 @@ func (siw *ServerInterfaceWrapper) FollowUserByUsername(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetTags operation middleware
-func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Request) {
+// RestoreArticle operation middleware
+func (siw *ServerInterfaceWrapper) RestoreArticle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTags(w, r)
+		siw.Handler.RestoreArticle(w, r, slug)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -675,8 +1122,19 @@ func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// GetCurrentUser operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+// GetArticleRevisions operation middleware
+func (siw *ServerInterfaceWrapper) GetArticleRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -685,7 +1143,7 @@ func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCurrentUser(w, r)
+		siw.Handler.GetArticleRevisions(w, r, slug)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -695,8 +1153,28 @@ func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCurrentUser operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCurrentUser(w http.ResponseWriter, r *http.Request) {
+// GetArticleRevision operation middleware
+func (siw *ServerInterfaceWrapper) GetArticleRevision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "number" -------------
+	var number int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "number", chi.URLParam(r, "number"), &number, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "number", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -705,7 +1183,7 @@ func (siw *ServerInterfaceWrapper) UpdateCurrentUser(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCurrentUser(w, r)
+		siw.Handler.GetArticleRevision(w, r, slug, number)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -715,11 +1193,48 @@ func (siw *ServerInterfaceWrapper) UpdateCurrentUser(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+// GetArticleRevisionDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetArticleRevisionDiff(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "number" -------------
+	var number int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "number", chi.URLParam(r, "number"), &number, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "number", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArticleRevisionDiffParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateUser(w, r)
+		siw.Handler.GetArticleRevisionDiff(w, r, slug, number, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -729,11 +1244,61 @@ func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+// RestoreArticleRevision operation middleware
+func (siw *ServerInterfaceWrapper) RestoreArticleRevision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "number" -------------
+	var number int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "number", chi.URLParam(r, "number"), &number, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "number", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreArticleRevisionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
+		siw.Handler.RestoreArticleRevision(w, r, slug, number, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -743,957 +1308,4179 @@ func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PreviewMarkdown operation middleware
+func (siw *ServerInterfaceWrapper) PreviewMarkdown(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, TokenScopes, []string{"articles:write"})
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewMarkdown(w, r)
+	}))
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type RequiredParamError struct {
-	ParamName string
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// GetProfileByUsername operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileByUsername(w http.ResponseWriter, r *http.Request) {
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	var err error
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProfileByUsername(w, r, username)
+	}))
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// UnfollowUserByUsername operation middleware
+func (siw *ServerInterfaceWrapper) UnfollowUserByUsername(w http.ResponseWriter, r *http.Request) {
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	var err error
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	ctx := r.Context()
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	ctx = context.WithValue(ctx, TokenScopes, []string{"profiles:write"})
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	r = r.WithContext(ctx)
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnfollowUserByUsername(w, r, username)
+	}))
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/articles", wrapper.GetArticles)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/articles", wrapper.CreateArticle)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/articles/feed", wrapper.GetArticlesFeed)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/articles/{slug}", wrapper.DeleteArticle)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/articles/{slug}", wrapper.GetArticle)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/articles/{slug}", wrapper.UpdateArticle)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/articles/{slug}/comments", wrapper.GetArticleComments)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/articles/{slug}/comments", wrapper.CreateArticleComment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/articles/{slug}/comments/{id}", wrapper.DeleteArticleComment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/articles/{slug}/favorite", wrapper.DeleteArticleFavorite)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/articles/{slug}/favorite", wrapper.CreateArticleFavorite)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/profiles/{username}", wrapper.GetProfileByUsername)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/profiles/{username}/follow", wrapper.UnfollowUserByUsername)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/profiles/{username}/follow", wrapper.FollowUserByUsername)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/tags", wrapper.GetTags)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/user", wrapper.GetCurrentUser)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/user", wrapper.UpdateCurrentUser)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users", wrapper.CreateUser)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/login", wrapper.Login)
-	})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return r
+	handler.ServeHTTP(w, r)
 }
 
-type EmptyOkResponseResponse struct {
-}
+// FollowUserByUsername operation middleware
+func (siw *ServerInterfaceWrapper) FollowUserByUsername(w http.ResponseWriter, r *http.Request) {
 
-type GenericErrorJSONResponse GenericErrorModel
+	var err error
 
-type MultipleArticlesResponseJSONResponse struct {
-	Articles []struct {
-		Author         Profile   `json:"author"`
-		CreatedAt      time.Time `json:"createdAt"`
-		Description    string    `json:"description"`
-		Favorited      bool      `json:"favorited"`
-		FavoritesCount int       `json:"favoritesCount"`
-		Slug           string    `json:"slug"`
-		TagList        []string  `json:"tagList"`
-		Title          string    `json:"title"`
-		UpdatedAt      time.Time `json:"updatedAt"`
-	} `json:"articles"`
-	ArticlesCount int `json:"articlesCount"`
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-type MultipleCommentsResponseJSONResponse struct {
-	Comments []Comment `json:"comments"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-type ProfileResponseJSONResponse struct {
-	Profile Profile `json:"profile"`
-}
+	ctx := r.Context()
 
-type SingleArticleResponseJSONResponse struct {
-	Article Article `json:"article"`
-}
+	ctx = context.WithValue(ctx, TokenScopes, []string{"profiles:write"})
 
-type SingleCommentResponseJSONResponse struct {
-	Comment Comment `json:"comment"`
-}
+	r = r.WithContext(ctx)
 
-type TagsResponseJSONResponse struct {
-	Tags []string `json:"tags"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FollowUserByUsername(w, r, username)
+	}))
 
-type UnauthorizedResponse struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UserResponseJSONResponse struct {
-	User User `json:"user"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetArticlesRequestObject struct {
-	Params GetArticlesParams
-}
+// GetProfileFollowers operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileFollowers(w http.ResponseWriter, r *http.Request) {
 
-type GetArticlesResponseObject interface {
-	VisitGetArticlesResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetArticles200JSONResponse struct {
-	MultipleArticlesResponseJSONResponse
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-func (response GetArticles200JSONResponse) VisitGetArticlesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetArticles401Response = UnauthorizedResponse
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
 
-func (response GetArticles401Response) VisitGetArticlesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type GetArticles422JSONResponse struct{ GenericErrorJSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileFollowersParams
 
-func (response GetArticles422JSONResponse) VisitGetArticlesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type CreateArticleRequestObject struct {
-	Body *CreateArticleJSONRequestBody
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type CreateArticleResponseObject interface {
-	VisitCreateArticleResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-type CreateArticle201JSONResponse struct {
-	SingleArticleResponseJSONResponse
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProfileFollowers(w, r, username, params)
+	}))
 
-func (response CreateArticle201JSONResponse) VisitCreateArticleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateArticle401Response = UnauthorizedResponse
+// GetProfileFollowing operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileFollowing(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateArticle401Response) VisitCreateArticleResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type CreateArticle422JSONResponse struct{ GenericErrorJSONResponse }
+	// ------------- Path parameter "username" -------------
+	var username string
 
-func (response CreateArticle422JSONResponse) VisitCreateArticleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetArticlesFeedRequestObject struct {
-	Params GetArticlesFeedParams
-}
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
 
-type GetArticlesFeedResponseObject interface {
-	VisitGetArticlesFeedResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetArticlesFeed200JSONResponse struct {
-	MultipleArticlesResponseJSONResponse
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileFollowingParams
 
-func (response GetArticlesFeed200JSONResponse) VisitGetArticlesFeedResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type GetArticlesFeed401Response = UnauthorizedResponse
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response GetArticlesFeed401Response) VisitGetArticlesFeedResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-type GetArticlesFeed422JSONResponse struct{ GenericErrorJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProfileFollowing(w, r, username, params)
+	}))
 
-func (response GetArticlesFeed422JSONResponse) VisitGetArticlesFeedResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteArticleRequestObject struct {
-	Slug string `json:"slug"`
-}
+// GetTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Request) {
 
-type DeleteArticleResponseObject interface {
-	VisitDeleteArticleResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTags(w, r)
+	}))
 
-type DeleteArticle200Response = EmptyOkResponseResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteArticle200Response) VisitDeleteArticleResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteArticle401Response = UnauthorizedResponse
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteArticle401Response) VisitDeleteArticleResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type DeleteArticle422JSONResponse struct{ GenericErrorJSONResponse }
+	ctx := r.Context()
 
-func (response DeleteArticle422JSONResponse) VisitDeleteArticleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetArticleRequestObject struct {
-	Slug string `json:"slug"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCurrentUserParams
 
-type GetArticleResponseObject interface {
-	VisitGetArticleResponse(w http.ResponseWriter) error
-}
+	headers := r.Header
 
-type GetArticle200JSONResponse struct {
-	SingleArticleResponseJSONResponse
-}
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
 
-func (response GetArticle200JSONResponse) VisitGetArticleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		params.IfNoneMatch = &IfNoneMatch
 
-type GetArticle422JSONResponse struct{ GenericErrorJSONResponse }
+	}
 
-func (response GetArticle422JSONResponse) VisitGetArticleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUser(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateArticleRequestObject struct {
-	Slug string `json:"slug"`
-	Body *UpdateArticleJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateArticleResponseObject interface {
-	VisitUpdateArticleResponse(w http.ResponseWriter) error
-}
+// UpdateCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCurrentUser(w http.ResponseWriter, r *http.Request) {
 
-type UpdateArticle200JSONResponse struct {
-	SingleArticleResponseJSONResponse
-}
+	var err error
 
-func (response UpdateArticle200JSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, TokenScopes, []string{"user:write"})
 
-type UpdateArticle401Response = UnauthorizedResponse
+	r = r.WithContext(ctx)
 
-func (response UpdateArticle401Response) VisitUpdateArticleResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateCurrentUserParams
 
-type UpdateArticle422JSONResponse struct{ GenericErrorJSONResponse }
+	headers := r.Header
 
-func (response UpdateArticle422JSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-type GetArticleCommentsRequestObject struct {
-	Slug string `json:"slug"`
-}
+		params.IfMatch = &IfMatch
 
-type GetArticleCommentsResponseObject interface {
-	VisitGetArticleCommentsResponse(w http.ResponseWriter) error
-}
+	}
 
-type GetArticleComments200JSONResponse struct {
-	MultipleCommentsResponseJSONResponse
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCurrentUser(w, r, params)
+	}))
 
-func (response GetArticleComments200JSONResponse) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetArticleComments401Response = UnauthorizedResponse
+// GetDraftArticles operation middleware
+func (siw *ServerInterfaceWrapper) GetDraftArticles(w http.ResponseWriter, r *http.Request) {
 
-func (response GetArticleComments401Response) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type GetArticleComments422JSONResponse struct{ GenericErrorJSONResponse }
+	ctx := r.Context()
 
-func (response GetArticleComments422JSONResponse) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateArticleCommentRequestObject struct {
-	Slug string `json:"slug"`
-	Body *CreateArticleCommentJSONRequestBody
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDraftArticlesParams
 
-type CreateArticleCommentResponseObject interface {
-	VisitCreateArticleCommentResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type CreateArticleComment200JSONResponse struct {
-	SingleCommentResponseJSONResponse
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-func (response CreateArticleComment200JSONResponse) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type CreateArticleComment401Response = UnauthorizedResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDraftArticles(w, r, params)
+	}))
 
-func (response CreateArticleComment401Response) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateArticleComment422JSONResponse struct{ GenericErrorJSONResponse }
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateArticleComment422JSONResponse) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, TokenScopes, []string{"tokens:write"})
 
-type DeleteArticleCommentRequestObject struct {
-	Slug string `json:"slug"`
-	Id   int    `json:"id"`
-}
+	r = r.WithContext(ctx)
 
-type DeleteArticleCommentResponseObject interface {
-	VisitDeleteArticleCommentResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Logout(w, r)
+	}))
 
-type DeleteArticleComment200Response = EmptyOkResponseResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteArticleComment200Response) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteArticleComment401Response = UnauthorizedResponse
-
-func (response DeleteArticleComment401Response) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// LogoutAll operation middleware
+func (siw *ServerInterfaceWrapper) LogoutAll(w http.ResponseWriter, r *http.Request) {
 
-type DeleteArticleComment422JSONResponse struct{ GenericErrorJSONResponse }
+	ctx := r.Context()
 
-func (response DeleteArticleComment422JSONResponse) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	ctx = context.WithValue(ctx, TokenScopes, []string{"tokens:write"})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type DeleteArticleFavoriteRequestObject struct {
-	Slug string `json:"slug"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LogoutAll(w, r)
+	}))
 
-type DeleteArticleFavoriteResponseObject interface {
-	VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteArticleFavorite200JSONResponse struct {
-	SingleArticleResponseJSONResponse
+	handler.ServeHTTP(w, r)
 }
 
-func (response DeleteArticleFavorite200JSONResponse) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetPersonalAccessTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type DeleteArticleFavorite401Response = UnauthorizedResponse
+	ctx = context.WithValue(ctx, TokenScopes, []string{"tokens:write"})
 
-func (response DeleteArticleFavorite401Response) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type DeleteArticleFavorite422JSONResponse struct{ GenericErrorJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPersonalAccessTokens(w, r)
+	}))
 
-func (response DeleteArticleFavorite422JSONResponse) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateArticleFavoriteRequestObject struct {
-	Slug string `json:"slug"`
-}
+// CreatePersonalAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
 
-type CreateArticleFavoriteResponseObject interface {
-	VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type CreateArticleFavorite200JSONResponse struct {
-	SingleArticleResponseJSONResponse
-}
+	ctx = context.WithValue(ctx, TokenScopes, []string{"tokens:write"})
 
-func (response CreateArticleFavorite200JSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePersonalAccessToken(w, r)
+	}))
 
-type CreateArticleFavorite401Response = UnauthorizedResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response CreateArticleFavorite401Response) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type CreateArticleFavorite422JSONResponse struct{ GenericErrorJSONResponse }
+// DeletePersonalAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) DeletePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateArticleFavorite422JSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type GetProfileByUsernameRequestObject struct {
-	Username string `json:"username"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetProfileByUsernameResponseObject interface {
-	VisitGetProfileByUsernameResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetProfileByUsername200JSONResponse struct{ ProfileResponseJSONResponse }
+	ctx = context.WithValue(ctx, TokenScopes, []string{"tokens:write"})
 
-func (response GetProfileByUsername200JSONResponse) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePersonalAccessToken(w, r, id)
+	}))
 
-type GetProfileByUsername401Response = UnauthorizedResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response GetProfileByUsername401Response) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type GetProfileByUsername422JSONResponse struct{ GenericErrorJSONResponse }
-
-func (response GetProfileByUsername422JSONResponse) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetTrashedArticles operation middleware
+func (siw *ServerInterfaceWrapper) GetTrashedArticles(w http.ResponseWriter, r *http.Request) {
 
-type UnfollowUserByUsernameRequestObject struct {
-	Username string `json:"username"`
-}
+	var err error
 
-type UnfollowUserByUsernameResponseObject interface {
-	VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type UnfollowUserByUsername200JSONResponse struct{ ProfileResponseJSONResponse }
+	ctx = context.WithValue(ctx, TokenScopes, []string{})
 
-func (response UnfollowUserByUsername200JSONResponse) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrashedArticlesParams
 
-type UnfollowUserByUsername401Response = UnauthorizedResponse
+	// ------------- Optional query parameter "offset" -------------
 
-func (response UnfollowUserByUsername401Response) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type UnfollowUserByUsername422JSONResponse struct{ GenericErrorJSONResponse }
+	// ------------- Optional query parameter "limit" -------------
 
-func (response UnfollowUserByUsername422JSONResponse) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrashedArticles(w, r, params)
+	}))
 
-type FollowUserByUsernameRequestObject struct {
-	Username string `json:"username"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type FollowUserByUsernameResponseObject interface {
-	VisitFollowUserByUsernameResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type FollowUserByUsername200JSONResponse struct{ ProfileResponseJSONResponse }
-
-func (response FollowUserByUsername200JSONResponse) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUser(w, r)
+	}))
 
-type FollowUserByUsername401Response = UnauthorizedResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response FollowUserByUsername401Response) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type FollowUserByUsername422JSONResponse struct{ GenericErrorJSONResponse }
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
 
-func (response FollowUserByUsername422JSONResponse) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetTagsRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type GetTagsResponseObject interface {
-	VisitGetTagsResponse(w http.ResponseWriter) error
-}
+// RefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) RefreshToken(w http.ResponseWriter, r *http.Request) {
 
-type GetTags200JSONResponse struct{ TagsResponseJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshToken(w, r)
+	}))
 
-func (response GetTags200JSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetTags422JSONResponse struct{ GenericErrorJSONResponse }
-
-func (response GetTags422JSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
-
-	return json.NewEncoder(w).Encode(response)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetCurrentUserRequestObject struct {
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type GetCurrentUserResponseObject interface {
-	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetCurrentUser200JSONResponse struct{ UserResponseJSONResponse }
-
-func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetCurrentUser401Response = UnauthorizedResponse
-
-func (response GetCurrentUser401Response) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type GetCurrentUser422JSONResponse struct{ GenericErrorJSONResponse }
-
-func (response GetCurrentUser422JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type UpdateCurrentUserRequestObject struct {
-	Body *UpdateCurrentUserJSONRequestBody
+type RequiredParamError struct {
+	ParamName string
 }
 
-type UpdateCurrentUserResponseObject interface {
-	VisitUpdateCurrentUserResponse(w http.ResponseWriter) error
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type UpdateCurrentUser200JSONResponse struct{ UserResponseJSONResponse }
-
-func (response UpdateCurrentUser200JSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-type UpdateCurrentUser401Response = UnauthorizedResponse
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
 
-func (response UpdateCurrentUser401Response) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-type UpdateCurrentUser422JSONResponse struct{ GenericErrorJSONResponse }
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
 
-func (response UpdateCurrentUser422JSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
 
-	return json.NewEncoder(w).Encode(response)
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
 }
 
-type CreateUserRequestObject struct {
-	Body *CreateUserJSONRequestBody
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-type CreateUserResponseObject interface {
-	VisitCreateUserResponse(w http.ResponseWriter) error
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
 }
 
-type CreateUser201JSONResponse struct{ UserResponseJSONResponse }
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
 
-	return json.NewEncoder(w).Encode(response)
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
 }
 
-type CreateUser422JSONResponse struct{ GenericErrorJSONResponse }
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
 
-func (response CreateUser422JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/articles", wrapper.GetArticles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/articles", wrapper.CreateArticle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/articles/feed", wrapper.GetArticlesFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/articles/search", wrapper.SearchArticles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/articles/{slug}", wrapper.DeleteArticle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/articles/{slug}", wrapper.GetArticle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/articles/{slug}", wrapper.UpdateArticle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/articles/{slug}/comments", wrapper.GetArticleComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/articles/{slug}/comments", wrapper.CreateArticleComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/articles/{slug}/comments/{id}", wrapper.DeleteArticleComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/articles/{slug}/comments/{id}", wrapper.UpdateArticleComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/articles/{slug}/favorite", wrapper.DeleteArticleFavorite)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/articles/{slug}/favorite", wrapper.CreateArticleFavorite)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/articles/{slug}/publish", wrapper.UnpublishArticle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/articles/{slug}/publish", wrapper.PublishArticle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/articles/{slug}/restore", wrapper.RestoreArticle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/articles/{slug}/revisions", wrapper.GetArticleRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/articles/{slug}/revisions/{number}", wrapper.GetArticleRevision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/articles/{slug}/revisions/{number}/diff", wrapper.GetArticleRevisionDiff)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/articles/{slug}/revisions/{number}/restore", wrapper.RestoreArticleRevision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/markdown/preview", wrapper.PreviewMarkdown)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/profiles/{username}", wrapper.GetProfileByUsername)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/profiles/{username}/follow", wrapper.UnfollowUserByUsername)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/profiles/{username}/follow", wrapper.FollowUserByUsername)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/profiles/{username}/followers", wrapper.GetProfileFollowers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/profiles/{username}/following", wrapper.GetProfileFollowing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tags", wrapper.GetTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user", wrapper.GetCurrentUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/user", wrapper.UpdateCurrentUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/drafts", wrapper.GetDraftArticles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/logout", wrapper.Logout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/logout/all", wrapper.LogoutAll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/tokens", wrapper.GetPersonalAccessTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/tokens", wrapper.CreatePersonalAccessToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/user/tokens/{id}", wrapper.DeletePersonalAccessToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/trash", wrapper.GetTrashedArticles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users", wrapper.CreateUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/login", wrapper.Login)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/token/refresh", wrapper.RefreshToken)
+	})
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
+	return r
 }
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
+type ArticleRevisionDiffResponseJSONResponse struct {
+	Diff ArticleRevisionDiff `json:"diff"`
 }
 
-type Login200JSONResponse struct{ UserResponseJSONResponse }
+type ArticleSearchResponseJSONResponse struct {
+	Articles []ArticleSearchResult `json:"articles"`
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ArticlesCount total number of articles matching the search, across all pages
+	ArticlesCount int `json:"articlesCount"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type ConflictJSONResponse GenericErrorModel
+type ConflictApplicationProblemPlusJSONResponse ProblemDetails
+
+type EmptyOkResponseResponse struct {
 }
 
-type Login401Response = UnauthorizedResponse
+type ForbiddenJSONResponse GenericErrorModel
+type ForbiddenApplicationProblemPlusJSONResponse ProblemDetails
 
-func (response Login401Response) VisitLoginResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type GenericErrorJSONResponse GenericErrorModel
+type GenericErrorApplicationProblemPlusJSONResponse ProblemDetails
+
+type MarkdownPreviewResponseJSONResponse struct {
+	BodyHtml string `json:"bodyHtml"`
 }
 
-type Login422JSONResponse struct{ GenericErrorJSONResponse }
+type MultipleArticleRevisionsResponseJSONResponse struct {
+	Revisions []ArticleRevision `json:"revisions"`
+}
 
-func (response Login422JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+type MultipleArticlesResponseJSONResponse struct {
+	Articles []struct {
+		Author Profile `json:"author"`
+
+		// CommentsCount Number of comments and replies, deleted ones excluded
+		CommentsCount int       `json:"commentsCount"`
+		CreatedAt     time.Time `json:"createdAt"`
+
+		// DeletedAt Deletion time of a trashed article
+		DeletedAt      *time.Time `json:"deletedAt,omitempty"`
+		Description    string     `json:"description"`
+		Favorited      bool       `json:"favorited"`
+		FavoritesCount int        `json:"favoritesCount"`
+
+		// ReadingTime Estimated reading time, in minutes
+		ReadingTime     int                    `json:"readingTime"`
+		Slug            string                 `json:"slug"`
+		TableOfContents []TableOfContentsEntry `json:"tableOfContents"`
+		TagList         []string               `json:"tagList"`
+		Title           string                 `json:"title"`
+		UpdatedAt       time.Time              `json:"updatedAt"`
+		WordCount       int                    `json:"wordCount"`
+	} `json:"articles"`
 
-	return json.NewEncoder(w).Encode(response)
+	// ArticlesCount total number of articles matching the filters, across all pages
+	ArticlesCount int `json:"articlesCount"`
+
+	// NextCursor Opaque cursor of the next page, absent on the last page
+	NextCursor *NextCursor `json:"nextCursor,omitempty"`
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get recent articles globally
-	// (GET /articles)
-	GetArticles(ctx context.Context, request GetArticlesRequestObject) (GetArticlesResponseObject, error)
-	// Create an article
-	// (POST /articles)
-	CreateArticle(ctx context.Context, request CreateArticleRequestObject) (CreateArticleResponseObject, error)
-	// Get recent articles from users you follow
-	// (GET /articles/feed)
-	GetArticlesFeed(ctx context.Context, request GetArticlesFeedRequestObject) (GetArticlesFeedResponseObject, error)
-	// Delete an article
-	// (DELETE /articles/{slug})
-	DeleteArticle(ctx context.Context, request DeleteArticleRequestObject) (DeleteArticleResponseObject, error)
-	// Get an article
-	// (GET /articles/{slug})
-	GetArticle(ctx context.Context, request GetArticleRequestObject) (GetArticleResponseObject, error)
-	// Update an article
-	// (PUT /articles/{slug})
-	UpdateArticle(ctx context.Context, request UpdateArticleRequestObject) (UpdateArticleResponseObject, error)
-	// Get comments for an article
-	// (GET /articles/{slug}/comments)
-	GetArticleComments(ctx context.Context, request GetArticleCommentsRequestObject) (GetArticleCommentsResponseObject, error)
-	// Create a comment for an article
-	// (POST /articles/{slug}/comments)
-	CreateArticleComment(ctx context.Context, request CreateArticleCommentRequestObject) (CreateArticleCommentResponseObject, error)
-	// Delete a comment for an article
-	// (DELETE /articles/{slug}/comments/{id})
-	DeleteArticleComment(ctx context.Context, request DeleteArticleCommentRequestObject) (DeleteArticleCommentResponseObject, error)
-	// Unfavorite an article
-	// (DELETE /articles/{slug}/favorite)
-	DeleteArticleFavorite(ctx context.Context, request DeleteArticleFavoriteRequestObject) (DeleteArticleFavoriteResponseObject, error)
-	// Favorite an article
-	// (POST /articles/{slug}/favorite)
-	CreateArticleFavorite(ctx context.Context, request CreateArticleFavoriteRequestObject) (CreateArticleFavoriteResponseObject, error)
-	// Get a profile
-	// (GET /profiles/{username})
-	GetProfileByUsername(ctx context.Context, request GetProfileByUsernameRequestObject) (GetProfileByUsernameResponseObject, error)
-	// Unfollow a user
-	// (DELETE /profiles/{username}/follow)
-	UnfollowUserByUsername(ctx context.Context, request UnfollowUserByUsernameRequestObject) (UnfollowUserByUsernameResponseObject, error)
-	// Follow a user
-	// (POST /profiles/{username}/follow)
-	FollowUserByUsername(ctx context.Context, request FollowUserByUsernameRequestObject) (FollowUserByUsernameResponseObject, error)
-	// Get tags
-	// (GET /tags)
-	GetTags(ctx context.Context, request GetTagsRequestObject) (GetTagsResponseObject, error)
-	// Get current user
-	// (GET /user)
-	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
-	// Update current user
-	// (PUT /user)
-	UpdateCurrentUser(ctx context.Context, request UpdateCurrentUserRequestObject) (UpdateCurrentUserResponseObject, error)
+type MultipleCommentsResponseJSONResponse struct {
+	Comments []Comment `json:"comments"`
 
-	// (POST /users)
-	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
-	// Existing user login
-	// (POST /users/login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// NextCursor Opaque cursor of the next page, absent on the last page
+	NextCursor *NextCursor `json:"nextCursor,omitempty"`
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type MultiplePersonalAccessTokensResponseJSONResponse struct {
+	Tokens []PersonalAccessToken `json:"tokens"`
+}
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type MultipleProfilesResponseJSONResponse struct {
+	// NextCursor Opaque cursor of the next page, absent on the last page
+	NextCursor *NextCursor `json:"nextCursor,omitempty"`
+	Profiles   []Profile   `json:"profiles"`
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+type NotFoundJSONResponse GenericErrorModel
+type NotFoundApplicationProblemPlusJSONResponse ProblemDetails
+
+type NotModifiedResponseHeaders struct {
+	ETag string
+}
+type NotModifiedResponse struct {
+	Headers NotModifiedResponseHeaders
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+type PreconditionFailedJSONResponse GenericErrorModel
+type PreconditionFailedApplicationProblemPlusJSONResponse ProblemDetails
+
+type ProfileResponseJSONResponse struct {
+	Profile Profile `json:"profile"`
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+type SingleArticleResponseJSONResponse struct {
+	Article Article `json:"article"`
+}
+
+type SingleArticleRevisionResponseJSONResponse struct {
+	Revision ArticleRevision `json:"revision"`
+}
+
+type SingleCommentResponseJSONResponse struct {
+	Comment Comment `json:"comment"`
+}
+
+type SinglePersonalAccessTokenResponseJSONResponse struct {
+	Token PersonalAccessToken `json:"token"`
+}
+
+type TagsResponseJSONResponse struct {
+	Tags []string `json:"tags"`
+}
+
+type UnauthorizedResponse struct {
+}
+
+type UserResponseJSONResponse struct {
+	User User `json:"user"`
+}
+
+type VersionedArticleResponseResponseHeaders struct {
+	ETag string
+}
+type VersionedArticleResponseJSONResponse struct {
+	Body struct {
+		Article Article `json:"article"`
+	}
+
+	Headers VersionedArticleResponseResponseHeaders
+}
+
+type VersionedUserResponseResponseHeaders struct {
+	ETag string
+}
+type VersionedUserResponseJSONResponse struct {
+	Body struct {
+		User User `json:"user"`
+	}
+
+	Headers VersionedUserResponseResponseHeaders
+}
+
+type GetArticlesRequestObject struct {
+	Params GetArticlesParams
+}
+
+type GetArticlesResponseObject interface {
+	VisitGetArticlesResponse(w http.ResponseWriter) error
+}
+
+type GetArticles200JSONResponse struct {
+	MultipleArticlesResponseJSONResponse
+}
+
+func (response GetArticles200JSONResponse) VisitGetArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticles401Response = UnauthorizedResponse
+
+func (response GetArticles401Response) VisitGetArticlesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetArticles422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetArticles422JSONResponse) VisitGetArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticles422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticles422ApplicationProblemPlusJSONResponse) VisitGetArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleRequestObject struct {
+	Body *CreateArticleJSONRequestBody
+}
+
+type CreateArticleResponseObject interface {
+	VisitCreateArticleResponse(w http.ResponseWriter) error
+}
+
+type CreateArticle201JSONResponse struct {
+	SingleArticleResponseJSONResponse
+}
+
+func (response CreateArticle201JSONResponse) VisitCreateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticle401Response = UnauthorizedResponse
+
+func (response CreateArticle401Response) VisitCreateArticleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateArticle409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateArticle409JSONResponse) VisitCreateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticle409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateArticle409ApplicationProblemPlusJSONResponse) VisitCreateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticle422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response CreateArticle422JSONResponse) VisitCreateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticle422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateArticle422ApplicationProblemPlusJSONResponse) VisitCreateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticlesFeedRequestObject struct {
+	Params GetArticlesFeedParams
+}
+
+type GetArticlesFeedResponseObject interface {
+	VisitGetArticlesFeedResponse(w http.ResponseWriter) error
+}
+
+type GetArticlesFeed200JSONResponse struct {
+	MultipleArticlesResponseJSONResponse
+}
+
+func (response GetArticlesFeed200JSONResponse) VisitGetArticlesFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticlesFeed401Response = UnauthorizedResponse
+
+func (response GetArticlesFeed401Response) VisitGetArticlesFeedResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetArticlesFeed422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetArticlesFeed422JSONResponse) VisitGetArticlesFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticlesFeed422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticlesFeed422ApplicationProblemPlusJSONResponse) VisitGetArticlesFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArticlesRequestObject struct {
+	Params SearchArticlesParams
+}
+
+type SearchArticlesResponseObject interface {
+	VisitSearchArticlesResponse(w http.ResponseWriter) error
+}
+
+type SearchArticles200JSONResponse struct {
+	ArticleSearchResponseJSONResponse
+}
+
+func (response SearchArticles200JSONResponse) VisitSearchArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArticles401Response = UnauthorizedResponse
+
+func (response SearchArticles401Response) VisitSearchArticlesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SearchArticles422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response SearchArticles422JSONResponse) VisitSearchArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArticles422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response SearchArticles422ApplicationProblemPlusJSONResponse) VisitSearchArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleRequestObject struct {
+	Slug string `json:"slug"`
+}
+
+type DeleteArticleResponseObject interface {
+	VisitDeleteArticleResponse(w http.ResponseWriter) error
+}
+
+type DeleteArticle200Response = EmptyOkResponseResponse
+
+func (response DeleteArticle200Response) VisitDeleteArticleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteArticle401Response = UnauthorizedResponse
+
+func (response DeleteArticle401Response) VisitDeleteArticleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteArticle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteArticle403JSONResponse) VisitDeleteArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticle403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteArticle403ApplicationProblemPlusJSONResponse) VisitDeleteArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteArticle404JSONResponse) VisitDeleteArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticle404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteArticle404ApplicationProblemPlusJSONResponse) VisitDeleteArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticle422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response DeleteArticle422JSONResponse) VisitDeleteArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticle422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteArticle422ApplicationProblemPlusJSONResponse) VisitDeleteArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRequestObject struct {
+	Slug   string `json:"slug"`
+	Params GetArticleParams
+}
+
+type GetArticleResponseObject interface {
+	VisitGetArticleResponse(w http.ResponseWriter) error
+}
+
+type GetArticle200JSONResponse struct {
+	VersionedArticleResponseJSONResponse
+}
+
+func (response GetArticle200JSONResponse) VisitGetArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetArticle301ResponseHeaders struct {
+	Location string
+}
+
+type GetArticle301Response struct {
+	Headers GetArticle301ResponseHeaders
+}
+
+func (response GetArticle301Response) VisitGetArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(301)
+	return nil
+}
+
+type GetArticle304Response = NotModifiedResponse
+
+func (response GetArticle304Response) VisitGetArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetArticle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArticle404JSONResponse) VisitGetArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticle404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticle404ApplicationProblemPlusJSONResponse) VisitGetArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticle422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetArticle422JSONResponse) VisitGetArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticle422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticle422ApplicationProblemPlusJSONResponse) VisitGetArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticleRequestObject struct {
+	Slug   string `json:"slug"`
+	Params UpdateArticleParams
+	Body   *UpdateArticleJSONRequestBody
+}
+
+type UpdateArticleResponseObject interface {
+	VisitUpdateArticleResponse(w http.ResponseWriter) error
+}
+
+type UpdateArticle200JSONResponse struct {
+	VersionedArticleResponseJSONResponse
+}
+
+func (response UpdateArticle200JSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateArticle401Response = UnauthorizedResponse
+
+func (response UpdateArticle401Response) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateArticle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateArticle403JSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticle403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateArticle403ApplicationProblemPlusJSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateArticle404JSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticle404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateArticle404ApplicationProblemPlusJSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticle412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response UpdateArticle412JSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticle412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateArticle412ApplicationProblemPlusJSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticle422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response UpdateArticle422JSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticle422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateArticle422ApplicationProblemPlusJSONResponse) VisitUpdateArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleCommentsRequestObject struct {
+	Slug   string `json:"slug"`
+	Params GetArticleCommentsParams
+}
+
+type GetArticleCommentsResponseObject interface {
+	VisitGetArticleCommentsResponse(w http.ResponseWriter) error
+}
+
+type GetArticleComments200JSONResponse struct {
+	MultipleCommentsResponseJSONResponse
+}
+
+func (response GetArticleComments200JSONResponse) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleComments401Response = UnauthorizedResponse
+
+func (response GetArticleComments401Response) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetArticleComments404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArticleComments404JSONResponse) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleComments404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticleComments404ApplicationProblemPlusJSONResponse) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleComments422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetArticleComments422JSONResponse) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleComments422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticleComments422ApplicationProblemPlusJSONResponse) VisitGetArticleCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleCommentRequestObject struct {
+	Slug string `json:"slug"`
+	Body *CreateArticleCommentJSONRequestBody
+}
+
+type CreateArticleCommentResponseObject interface {
+	VisitCreateArticleCommentResponse(w http.ResponseWriter) error
+}
+
+type CreateArticleComment200JSONResponse struct {
+	SingleCommentResponseJSONResponse
+}
+
+func (response CreateArticleComment200JSONResponse) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleComment401Response = UnauthorizedResponse
+
+func (response CreateArticleComment401Response) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateArticleComment404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateArticleComment404JSONResponse) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleComment404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateArticleComment404ApplicationProblemPlusJSONResponse) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleComment422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response CreateArticleComment422JSONResponse) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleComment422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateArticleComment422ApplicationProblemPlusJSONResponse) VisitCreateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleCommentRequestObject struct {
+	Slug string `json:"slug"`
+	Id   int    `json:"id"`
+}
+
+type DeleteArticleCommentResponseObject interface {
+	VisitDeleteArticleCommentResponse(w http.ResponseWriter) error
+}
+
+type DeleteArticleComment200Response = EmptyOkResponseResponse
+
+func (response DeleteArticleComment200Response) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteArticleComment401Response = UnauthorizedResponse
+
+func (response DeleteArticleComment401Response) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteArticleComment403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteArticleComment403JSONResponse) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleComment403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteArticleComment403ApplicationProblemPlusJSONResponse) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleComment404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteArticleComment404JSONResponse) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleComment404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteArticleComment404ApplicationProblemPlusJSONResponse) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleComment422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response DeleteArticleComment422JSONResponse) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleComment422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteArticleComment422ApplicationProblemPlusJSONResponse) VisitDeleteArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticleCommentRequestObject struct {
+	Slug string `json:"slug"`
+	Id   int    `json:"id"`
+	Body *UpdateArticleCommentJSONRequestBody
+}
+
+type UpdateArticleCommentResponseObject interface {
+	VisitUpdateArticleCommentResponse(w http.ResponseWriter) error
+}
+
+type UpdateArticleComment200JSONResponse struct {
+	SingleCommentResponseJSONResponse
+}
+
+func (response UpdateArticleComment200JSONResponse) VisitUpdateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticleComment401Response = UnauthorizedResponse
+
+func (response UpdateArticleComment401Response) VisitUpdateArticleCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateArticleComment403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateArticleComment403JSONResponse) VisitUpdateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticleComment403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateArticleComment403ApplicationProblemPlusJSONResponse) VisitUpdateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticleComment404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateArticleComment404JSONResponse) VisitUpdateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticleComment404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateArticleComment404ApplicationProblemPlusJSONResponse) VisitUpdateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticleComment422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response UpdateArticleComment422JSONResponse) VisitUpdateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArticleComment422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateArticleComment422ApplicationProblemPlusJSONResponse) VisitUpdateArticleCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleFavoriteRequestObject struct {
+	Slug string `json:"slug"`
+}
+
+type DeleteArticleFavoriteResponseObject interface {
+	VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error
+}
+
+type DeleteArticleFavorite200JSONResponse struct {
+	SingleArticleResponseJSONResponse
+}
+
+func (response DeleteArticleFavorite200JSONResponse) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleFavorite401Response = UnauthorizedResponse
+
+func (response DeleteArticleFavorite401Response) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteArticleFavorite404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteArticleFavorite404JSONResponse) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleFavorite404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteArticleFavorite404ApplicationProblemPlusJSONResponse) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleFavorite422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response DeleteArticleFavorite422JSONResponse) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArticleFavorite422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteArticleFavorite422ApplicationProblemPlusJSONResponse) VisitDeleteArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleFavoriteRequestObject struct {
+	Slug string `json:"slug"`
+}
+
+type CreateArticleFavoriteResponseObject interface {
+	VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error
+}
+
+type CreateArticleFavorite200JSONResponse struct {
+	SingleArticleResponseJSONResponse
+}
+
+func (response CreateArticleFavorite200JSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleFavorite401Response = UnauthorizedResponse
+
+func (response CreateArticleFavorite401Response) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateArticleFavorite404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateArticleFavorite404JSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleFavorite404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateArticleFavorite404ApplicationProblemPlusJSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleFavorite409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateArticleFavorite409JSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleFavorite409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateArticleFavorite409ApplicationProblemPlusJSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleFavorite422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response CreateArticleFavorite422JSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArticleFavorite422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateArticleFavorite422ApplicationProblemPlusJSONResponse) VisitCreateArticleFavoriteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnpublishArticleRequestObject struct {
+	Slug string `json:"slug"`
+}
+
+type UnpublishArticleResponseObject interface {
+	VisitUnpublishArticleResponse(w http.ResponseWriter) error
+}
+
+type UnpublishArticle200JSONResponse struct {
+	SingleArticleResponseJSONResponse
+}
+
+func (response UnpublishArticle200JSONResponse) VisitUnpublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnpublishArticle401Response = UnauthorizedResponse
+
+func (response UnpublishArticle401Response) VisitUnpublishArticleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UnpublishArticle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UnpublishArticle403JSONResponse) VisitUnpublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnpublishArticle403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UnpublishArticle403ApplicationProblemPlusJSONResponse) VisitUnpublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnpublishArticle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UnpublishArticle404JSONResponse) VisitUnpublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnpublishArticle404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UnpublishArticle404ApplicationProblemPlusJSONResponse) VisitUnpublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnpublishArticle422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response UnpublishArticle422JSONResponse) VisitUnpublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnpublishArticle422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UnpublishArticle422ApplicationProblemPlusJSONResponse) VisitUnpublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticleRequestObject struct {
+	Slug   string `json:"slug"`
+	Params PublishArticleParams
+}
+
+type PublishArticleResponseObject interface {
+	VisitPublishArticleResponse(w http.ResponseWriter) error
+}
+
+type PublishArticle200JSONResponse struct {
+	SingleArticleResponseJSONResponse
+}
+
+func (response PublishArticle200JSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticle401Response = UnauthorizedResponse
+
+func (response PublishArticle401Response) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PublishArticle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PublishArticle403JSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticle403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PublishArticle403ApplicationProblemPlusJSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PublishArticle404JSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticle404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PublishArticle404ApplicationProblemPlusJSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticle409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PublishArticle409JSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticle409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PublishArticle409ApplicationProblemPlusJSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticle422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response PublishArticle422JSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishArticle422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PublishArticle422ApplicationProblemPlusJSONResponse) VisitPublishArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRequestObject struct {
+	Slug string `json:"slug"`
+}
+
+type RestoreArticleResponseObject interface {
+	VisitRestoreArticleResponse(w http.ResponseWriter) error
+}
+
+type RestoreArticle200JSONResponse struct {
+	SingleArticleResponseJSONResponse
+}
+
+func (response RestoreArticle200JSONResponse) VisitRestoreArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticle401Response = UnauthorizedResponse
+
+func (response RestoreArticle401Response) VisitRestoreArticleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RestoreArticle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreArticle404JSONResponse) VisitRestoreArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticle404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreArticle404ApplicationProblemPlusJSONResponse) VisitRestoreArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticle422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response RestoreArticle422JSONResponse) VisitRestoreArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticle422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreArticle422ApplicationProblemPlusJSONResponse) VisitRestoreArticleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisionsRequestObject struct {
+	Slug string `json:"slug"`
+}
+
+type GetArticleRevisionsResponseObject interface {
+	VisitGetArticleRevisionsResponse(w http.ResponseWriter) error
+}
+
+type GetArticleRevisions200JSONResponse struct {
+	MultipleArticleRevisionsResponseJSONResponse
+}
+
+func (response GetArticleRevisions200JSONResponse) VisitGetArticleRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArticleRevisions404JSONResponse) VisitGetArticleRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisions404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticleRevisions404ApplicationProblemPlusJSONResponse) VisitGetArticleRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisions422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetArticleRevisions422JSONResponse) VisitGetArticleRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisions422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticleRevisions422ApplicationProblemPlusJSONResponse) VisitGetArticleRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisionRequestObject struct {
+	Slug   string `json:"slug"`
+	Number int    `json:"number"`
+}
+
+type GetArticleRevisionResponseObject interface {
+	VisitGetArticleRevisionResponse(w http.ResponseWriter) error
+}
+
+type GetArticleRevision200JSONResponse struct {
+	SingleArticleRevisionResponseJSONResponse
+}
+
+func (response GetArticleRevision200JSONResponse) VisitGetArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevision404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArticleRevision404JSONResponse) VisitGetArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevision404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticleRevision404ApplicationProblemPlusJSONResponse) VisitGetArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevision422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetArticleRevision422JSONResponse) VisitGetArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevision422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticleRevision422ApplicationProblemPlusJSONResponse) VisitGetArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisionDiffRequestObject struct {
+	Slug   string `json:"slug"`
+	Number int    `json:"number"`
+	Params GetArticleRevisionDiffParams
+}
+
+type GetArticleRevisionDiffResponseObject interface {
+	VisitGetArticleRevisionDiffResponse(w http.ResponseWriter) error
+}
+
+type GetArticleRevisionDiff200JSONResponse struct {
+	ArticleRevisionDiffResponseJSONResponse
+}
+
+func (response GetArticleRevisionDiff200JSONResponse) VisitGetArticleRevisionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisionDiff404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArticleRevisionDiff404JSONResponse) VisitGetArticleRevisionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisionDiff404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticleRevisionDiff404ApplicationProblemPlusJSONResponse) VisitGetArticleRevisionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisionDiff422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetArticleRevisionDiff422JSONResponse) VisitGetArticleRevisionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArticleRevisionDiff422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetArticleRevisionDiff422ApplicationProblemPlusJSONResponse) VisitGetArticleRevisionDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevisionRequestObject struct {
+	Slug   string `json:"slug"`
+	Number int    `json:"number"`
+	Params RestoreArticleRevisionParams
+}
+
+type RestoreArticleRevisionResponseObject interface {
+	VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error
+}
+
+type RestoreArticleRevision200JSONResponse struct {
+	VersionedArticleResponseJSONResponse
+}
+
+func (response RestoreArticleRevision200JSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreArticleRevision401Response = UnauthorizedResponse
+
+func (response RestoreArticleRevision401Response) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RestoreArticleRevision403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RestoreArticleRevision403JSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreArticleRevision403ApplicationProblemPlusJSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreArticleRevision404JSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreArticleRevision404ApplicationProblemPlusJSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RestoreArticleRevision409JSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreArticleRevision409ApplicationProblemPlusJSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response RestoreArticleRevision412JSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreArticleRevision412ApplicationProblemPlusJSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response RestoreArticleRevision422JSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreArticleRevision422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreArticleRevision422ApplicationProblemPlusJSONResponse) VisitRestoreArticleRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewMarkdownRequestObject struct {
+	Body *PreviewMarkdownJSONRequestBody
+}
+
+type PreviewMarkdownResponseObject interface {
+	VisitPreviewMarkdownResponse(w http.ResponseWriter) error
+}
+
+type PreviewMarkdown200JSONResponse struct {
+	MarkdownPreviewResponseJSONResponse
+}
+
+func (response PreviewMarkdown200JSONResponse) VisitPreviewMarkdownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewMarkdown401Response = UnauthorizedResponse
+
+func (response PreviewMarkdown401Response) VisitPreviewMarkdownResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PreviewMarkdown422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response PreviewMarkdown422JSONResponse) VisitPreviewMarkdownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewMarkdown422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PreviewMarkdown422ApplicationProblemPlusJSONResponse) VisitPreviewMarkdownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileByUsernameRequestObject struct {
+	Username string `json:"username"`
+}
+
+type GetProfileByUsernameResponseObject interface {
+	VisitGetProfileByUsernameResponse(w http.ResponseWriter) error
+}
+
+type GetProfileByUsername200JSONResponse struct{ ProfileResponseJSONResponse }
+
+func (response GetProfileByUsername200JSONResponse) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileByUsername401Response = UnauthorizedResponse
+
+func (response GetProfileByUsername401Response) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetProfileByUsername404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetProfileByUsername404JSONResponse) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileByUsername404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetProfileByUsername404ApplicationProblemPlusJSONResponse) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileByUsername422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetProfileByUsername422JSONResponse) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileByUsername422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetProfileByUsername422ApplicationProblemPlusJSONResponse) VisitGetProfileByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnfollowUserByUsernameRequestObject struct {
+	Username string `json:"username"`
+}
+
+type UnfollowUserByUsernameResponseObject interface {
+	VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error
+}
+
+type UnfollowUserByUsername200JSONResponse struct{ ProfileResponseJSONResponse }
+
+func (response UnfollowUserByUsername200JSONResponse) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnfollowUserByUsername401Response = UnauthorizedResponse
+
+func (response UnfollowUserByUsername401Response) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UnfollowUserByUsername404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UnfollowUserByUsername404JSONResponse) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnfollowUserByUsername404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UnfollowUserByUsername404ApplicationProblemPlusJSONResponse) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnfollowUserByUsername422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response UnfollowUserByUsername422JSONResponse) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnfollowUserByUsername422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UnfollowUserByUsername422ApplicationProblemPlusJSONResponse) VisitUnfollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FollowUserByUsernameRequestObject struct {
+	Username string `json:"username"`
+}
+
+type FollowUserByUsernameResponseObject interface {
+	VisitFollowUserByUsernameResponse(w http.ResponseWriter) error
+}
+
+type FollowUserByUsername200JSONResponse struct{ ProfileResponseJSONResponse }
+
+func (response FollowUserByUsername200JSONResponse) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FollowUserByUsername401Response = UnauthorizedResponse
+
+func (response FollowUserByUsername401Response) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type FollowUserByUsername404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response FollowUserByUsername404JSONResponse) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FollowUserByUsername404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response FollowUserByUsername404ApplicationProblemPlusJSONResponse) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FollowUserByUsername422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response FollowUserByUsername422JSONResponse) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FollowUserByUsername422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response FollowUserByUsername422ApplicationProblemPlusJSONResponse) VisitFollowUserByUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowersRequestObject struct {
+	Username string `json:"username"`
+	Params   GetProfileFollowersParams
+}
+
+type GetProfileFollowersResponseObject interface {
+	VisitGetProfileFollowersResponse(w http.ResponseWriter) error
+}
+
+type GetProfileFollowers200JSONResponse struct {
+	MultipleProfilesResponseJSONResponse
+}
+
+func (response GetProfileFollowers200JSONResponse) VisitGetProfileFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowers401Response = UnauthorizedResponse
+
+func (response GetProfileFollowers401Response) VisitGetProfileFollowersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetProfileFollowers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetProfileFollowers404JSONResponse) VisitGetProfileFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowers404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetProfileFollowers404ApplicationProblemPlusJSONResponse) VisitGetProfileFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowers422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetProfileFollowers422JSONResponse) VisitGetProfileFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowers422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetProfileFollowers422ApplicationProblemPlusJSONResponse) VisitGetProfileFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowingRequestObject struct {
+	Username string `json:"username"`
+	Params   GetProfileFollowingParams
+}
+
+type GetProfileFollowingResponseObject interface {
+	VisitGetProfileFollowingResponse(w http.ResponseWriter) error
+}
+
+type GetProfileFollowing200JSONResponse struct {
+	MultipleProfilesResponseJSONResponse
+}
+
+func (response GetProfileFollowing200JSONResponse) VisitGetProfileFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowing401Response = UnauthorizedResponse
+
+func (response GetProfileFollowing401Response) VisitGetProfileFollowingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetProfileFollowing404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetProfileFollowing404JSONResponse) VisitGetProfileFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowing404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetProfileFollowing404ApplicationProblemPlusJSONResponse) VisitGetProfileFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowing422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetProfileFollowing422JSONResponse) VisitGetProfileFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileFollowing422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetProfileFollowing422ApplicationProblemPlusJSONResponse) VisitGetProfileFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsRequestObject struct {
+}
+
+type GetTagsResponseObject interface {
+	VisitGetTagsResponse(w http.ResponseWriter) error
+}
+
+type GetTags200JSONResponse struct{ TagsResponseJSONResponse }
+
+func (response GetTags200JSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTags422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetTags422JSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTags422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTags422ApplicationProblemPlusJSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUserRequestObject struct {
+	Params GetCurrentUserParams
+}
+
+type GetCurrentUserResponseObject interface {
+	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUser200JSONResponse struct {
+	VersionedUserResponseJSONResponse
+}
+
+func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCurrentUser304Response = NotModifiedResponse
+
+func (response GetCurrentUser304Response) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetCurrentUser401Response = UnauthorizedResponse
+
+func (response GetCurrentUser401Response) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCurrentUser422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetCurrentUser422JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCurrentUser422ApplicationProblemPlusJSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUserRequestObject struct {
+	Params UpdateCurrentUserParams
+	Body   *UpdateCurrentUserJSONRequestBody
+}
+
+type UpdateCurrentUserResponseObject interface {
+	VisitUpdateCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type UpdateCurrentUser200JSONResponse struct {
+	VersionedUserResponseJSONResponse
+}
+
+func (response UpdateCurrentUser200JSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCurrentUser401Response = UnauthorizedResponse
+
+func (response UpdateCurrentUser401Response) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateCurrentUser403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateCurrentUser403JSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCurrentUser403ApplicationProblemPlusJSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser409JSONResponse struct{ ConflictJSONResponse }
+
+func (response UpdateCurrentUser409JSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCurrentUser409ApplicationProblemPlusJSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response UpdateCurrentUser412JSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCurrentUser412ApplicationProblemPlusJSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response UpdateCurrentUser422JSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCurrentUser422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCurrentUser422ApplicationProblemPlusJSONResponse) VisitUpdateCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDraftArticlesRequestObject struct {
+	Params GetDraftArticlesParams
+}
+
+type GetDraftArticlesResponseObject interface {
+	VisitGetDraftArticlesResponse(w http.ResponseWriter) error
+}
+
+type GetDraftArticles200JSONResponse struct {
+	MultipleArticlesResponseJSONResponse
+}
+
+func (response GetDraftArticles200JSONResponse) VisitGetDraftArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDraftArticles401Response = UnauthorizedResponse
+
+func (response GetDraftArticles401Response) VisitGetDraftArticlesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetDraftArticles422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetDraftArticles422JSONResponse) VisitGetDraftArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDraftArticles422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetDraftArticles422ApplicationProblemPlusJSONResponse) VisitGetDraftArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutRequestObject struct {
+}
+
+type LogoutResponseObject interface {
+	VisitLogoutResponse(w http.ResponseWriter) error
+}
+
+type Logout200Response = EmptyOkResponseResponse
+
+func (response Logout200Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type Logout401Response = UnauthorizedResponse
+
+func (response Logout401Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type Logout422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response Logout422JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Logout422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response Logout422ApplicationProblemPlusJSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutAllRequestObject struct {
+}
+
+type LogoutAllResponseObject interface {
+	VisitLogoutAllResponse(w http.ResponseWriter) error
+}
+
+type LogoutAll200Response = EmptyOkResponseResponse
+
+func (response LogoutAll200Response) VisitLogoutAllResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type LogoutAll401Response = UnauthorizedResponse
+
+func (response LogoutAll401Response) VisitLogoutAllResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type LogoutAll422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response LogoutAll422JSONResponse) VisitLogoutAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutAll422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response LogoutAll422ApplicationProblemPlusJSONResponse) VisitLogoutAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPersonalAccessTokensRequestObject struct {
+}
+
+type GetPersonalAccessTokensResponseObject interface {
+	VisitGetPersonalAccessTokensResponse(w http.ResponseWriter) error
+}
+
+type GetPersonalAccessTokens200JSONResponse struct {
+	MultiplePersonalAccessTokensResponseJSONResponse
+}
+
+func (response GetPersonalAccessTokens200JSONResponse) VisitGetPersonalAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPersonalAccessTokens401Response = UnauthorizedResponse
+
+func (response GetPersonalAccessTokens401Response) VisitGetPersonalAccessTokensResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetPersonalAccessTokens422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetPersonalAccessTokens422JSONResponse) VisitGetPersonalAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPersonalAccessTokens422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPersonalAccessTokens422ApplicationProblemPlusJSONResponse) VisitGetPersonalAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessTokenRequestObject struct {
+	Body *CreatePersonalAccessTokenJSONRequestBody
+}
+
+type CreatePersonalAccessTokenResponseObject interface {
+	VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type CreatePersonalAccessToken201JSONResponse struct {
+	SinglePersonalAccessTokenResponseJSONResponse
+}
+
+func (response CreatePersonalAccessToken201JSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessToken401Response = UnauthorizedResponse
+
+func (response CreatePersonalAccessToken401Response) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreatePersonalAccessToken422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response CreatePersonalAccessToken422JSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessToken422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePersonalAccessToken422ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessTokenRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeletePersonalAccessTokenResponseObject interface {
+	VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type DeletePersonalAccessToken200Response = EmptyOkResponseResponse
+
+func (response DeletePersonalAccessToken200Response) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeletePersonalAccessToken401Response = UnauthorizedResponse
+
+func (response DeletePersonalAccessToken401Response) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeletePersonalAccessToken404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeletePersonalAccessToken404JSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessToken404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePersonalAccessToken404ApplicationProblemPlusJSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessToken422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response DeletePersonalAccessToken422JSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessToken422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePersonalAccessToken422ApplicationProblemPlusJSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrashedArticlesRequestObject struct {
+	Params GetTrashedArticlesParams
+}
+
+type GetTrashedArticlesResponseObject interface {
+	VisitGetTrashedArticlesResponse(w http.ResponseWriter) error
+}
+
+type GetTrashedArticles200JSONResponse struct {
+	MultipleArticlesResponseJSONResponse
+}
+
+func (response GetTrashedArticles200JSONResponse) VisitGetTrashedArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrashedArticles401Response = UnauthorizedResponse
+
+func (response GetTrashedArticles401Response) VisitGetTrashedArticlesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTrashedArticles422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response GetTrashedArticles422JSONResponse) VisitGetTrashedArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrashedArticles422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTrashedArticles422ApplicationProblemPlusJSONResponse) VisitGetTrashedArticlesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUserRequestObject struct {
+	Body *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(w http.ResponseWriter) error
+}
+
+type CreateUser201JSONResponse struct{ UserResponseJSONResponse }
+
+func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateUser409JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateUser409ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response CreateUser422JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateUser422ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse struct{ UserResponseJSONResponse }
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login401Response = UnauthorizedResponse
+
+func (response Login401Response) VisitLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type Login422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response Login422JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response Login422ApplicationProblemPlusJSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokenRequestObject struct {
+	Body *RefreshTokenJSONRequestBody
+}
+
+type RefreshTokenResponseObject interface {
+	VisitRefreshTokenResponse(w http.ResponseWriter) error
+}
+
+type RefreshToken200JSONResponse struct{ UserResponseJSONResponse }
+
+func (response RefreshToken200JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshToken401Response = UnauthorizedResponse
+
+func (response RefreshToken401Response) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RefreshToken422JSONResponse struct{ GenericErrorJSONResponse }
+
+func (response RefreshToken422JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshToken422ApplicationProblemPlusJSONResponse struct {
+	GenericErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RefreshToken422ApplicationProblemPlusJSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get recent articles globally
+	// (GET /articles)
+	GetArticles(ctx context.Context, request GetArticlesRequestObject) (GetArticlesResponseObject, error)
+	// Create an article
+	// (POST /articles)
+	CreateArticle(ctx context.Context, request CreateArticleRequestObject) (CreateArticleResponseObject, error)
+	// Get recent articles from users you follow
+	// (GET /articles/feed)
+	GetArticlesFeed(ctx context.Context, request GetArticlesFeedRequestObject) (GetArticlesFeedResponseObject, error)
+	// Search articles
+	// (GET /articles/search)
+	SearchArticles(ctx context.Context, request SearchArticlesRequestObject) (SearchArticlesResponseObject, error)
+	// Delete an article
+	// (DELETE /articles/{slug})
+	DeleteArticle(ctx context.Context, request DeleteArticleRequestObject) (DeleteArticleResponseObject, error)
+	// Get an article
+	// (GET /articles/{slug})
+	GetArticle(ctx context.Context, request GetArticleRequestObject) (GetArticleResponseObject, error)
+	// Update an article
+	// (PUT /articles/{slug})
+	UpdateArticle(ctx context.Context, request UpdateArticleRequestObject) (UpdateArticleResponseObject, error)
+	// Get comments for an article
+	// (GET /articles/{slug}/comments)
+	GetArticleComments(ctx context.Context, request GetArticleCommentsRequestObject) (GetArticleCommentsResponseObject, error)
+	// Create a comment for an article
+	// (POST /articles/{slug}/comments)
+	CreateArticleComment(ctx context.Context, request CreateArticleCommentRequestObject) (CreateArticleCommentResponseObject, error)
+	// Delete a comment for an article
+	// (DELETE /articles/{slug}/comments/{id})
+	DeleteArticleComment(ctx context.Context, request DeleteArticleCommentRequestObject) (DeleteArticleCommentResponseObject, error)
+	// Update a comment for an article
+	// (PUT /articles/{slug}/comments/{id})
+	UpdateArticleComment(ctx context.Context, request UpdateArticleCommentRequestObject) (UpdateArticleCommentResponseObject, error)
+	// Unfavorite an article
+	// (DELETE /articles/{slug}/favorite)
+	DeleteArticleFavorite(ctx context.Context, request DeleteArticleFavoriteRequestObject) (DeleteArticleFavoriteResponseObject, error)
+	// Favorite an article
+	// (POST /articles/{slug}/favorite)
+	CreateArticleFavorite(ctx context.Context, request CreateArticleFavoriteRequestObject) (CreateArticleFavoriteResponseObject, error)
+	// Unpublish an article
+	// (DELETE /articles/{slug}/publish)
+	UnpublishArticle(ctx context.Context, request UnpublishArticleRequestObject) (UnpublishArticleResponseObject, error)
+	// Publish an article
+	// (POST /articles/{slug}/publish)
+	PublishArticle(ctx context.Context, request PublishArticleRequestObject) (PublishArticleResponseObject, error)
+	// Restore a trashed article
+	// (POST /articles/{slug}/restore)
+	RestoreArticle(ctx context.Context, request RestoreArticleRequestObject) (RestoreArticleResponseObject, error)
+	// Get the revisions of an article
+	// (GET /articles/{slug}/revisions)
+	GetArticleRevisions(ctx context.Context, request GetArticleRevisionsRequestObject) (GetArticleRevisionsResponseObject, error)
+	// Get a revision of an article
+	// (GET /articles/{slug}/revisions/{number})
+	GetArticleRevision(ctx context.Context, request GetArticleRevisionRequestObject) (GetArticleRevisionResponseObject, error)
+	// Diff two revisions of an article
+	// (GET /articles/{slug}/revisions/{number}/diff)
+	GetArticleRevisionDiff(ctx context.Context, request GetArticleRevisionDiffRequestObject) (GetArticleRevisionDiffResponseObject, error)
+	// Restore a revision of an article
+	// (POST /articles/{slug}/revisions/{number}/restore)
+	RestoreArticleRevision(ctx context.Context, request RestoreArticleRevisionRequestObject) (RestoreArticleRevisionResponseObject, error)
+	// Preview markdown
+	// (POST /markdown/preview)
+	PreviewMarkdown(ctx context.Context, request PreviewMarkdownRequestObject) (PreviewMarkdownResponseObject, error)
+	// Get a profile
+	// (GET /profiles/{username})
+	GetProfileByUsername(ctx context.Context, request GetProfileByUsernameRequestObject) (GetProfileByUsernameResponseObject, error)
+	// Unfollow a user
+	// (DELETE /profiles/{username}/follow)
+	UnfollowUserByUsername(ctx context.Context, request UnfollowUserByUsernameRequestObject) (UnfollowUserByUsernameResponseObject, error)
+	// Follow a user
+	// (POST /profiles/{username}/follow)
+	FollowUserByUsername(ctx context.Context, request FollowUserByUsernameRequestObject) (FollowUserByUsernameResponseObject, error)
+	// Get the followers of a user
+	// (GET /profiles/{username}/followers)
+	GetProfileFollowers(ctx context.Context, request GetProfileFollowersRequestObject) (GetProfileFollowersResponseObject, error)
+	// Get the users a user follows
+	// (GET /profiles/{username}/following)
+	GetProfileFollowing(ctx context.Context, request GetProfileFollowingRequestObject) (GetProfileFollowingResponseObject, error)
+	// Get tags
+	// (GET /tags)
+	GetTags(ctx context.Context, request GetTagsRequestObject) (GetTagsResponseObject, error)
+	// Get current user
+	// (GET /user)
+	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
+	// Update current user
+	// (PUT /user)
+	UpdateCurrentUser(ctx context.Context, request UpdateCurrentUserRequestObject) (UpdateCurrentUserResponseObject, error)
+	// Get the drafts
+	// (GET /user/drafts)
+	GetDraftArticles(ctx context.Context, request GetDraftArticlesRequestObject) (GetDraftArticlesResponseObject, error)
+	// Log out
+	// (POST /user/logout)
+	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
+	// Log out everywhere
+	// (POST /user/logout/all)
+	LogoutAll(ctx context.Context, request LogoutAllRequestObject) (LogoutAllResponseObject, error)
+	// List personal access tokens
+	// (GET /user/tokens)
+	GetPersonalAccessTokens(ctx context.Context, request GetPersonalAccessTokensRequestObject) (GetPersonalAccessTokensResponseObject, error)
+	// Create a personal access token
+	// (POST /user/tokens)
+	CreatePersonalAccessToken(ctx context.Context, request CreatePersonalAccessTokenRequestObject) (CreatePersonalAccessTokenResponseObject, error)
+	// Revoke a personal access token
+	// (DELETE /user/tokens/{id})
+	DeletePersonalAccessToken(ctx context.Context, request DeletePersonalAccessTokenRequestObject) (DeletePersonalAccessTokenResponseObject, error)
+	// Get the trashed articles
+	// (GET /user/trash)
+	GetTrashedArticles(ctx context.Context, request GetTrashedArticlesRequestObject) (GetTrashedArticlesResponseObject, error)
+
+	// (POST /users)
+	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
+	// Existing user login
+	// (POST /users/login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Refresh the access token
+	// (POST /users/token/refresh)
+	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetArticles operation middleware
+func (sh *strictHandler) GetArticles(w http.ResponseWriter, r *http.Request, params GetArticlesParams) {
+	var request GetArticlesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArticles(ctx, request.(GetArticlesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArticles")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArticlesResponseObject); ok {
+		if err := validResponse.VisitGetArticlesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateArticle operation middleware
+func (sh *strictHandler) CreateArticle(w http.ResponseWriter, r *http.Request) {
+	var request CreateArticleRequestObject
+
+	var body CreateArticleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateArticle(ctx, request.(CreateArticleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateArticle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateArticleResponseObject); ok {
+		if err := validResponse.VisitCreateArticleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArticlesFeed operation middleware
+func (sh *strictHandler) GetArticlesFeed(w http.ResponseWriter, r *http.Request, params GetArticlesFeedParams) {
+	var request GetArticlesFeedRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArticlesFeed(ctx, request.(GetArticlesFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArticlesFeed")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArticlesFeedResponseObject); ok {
+		if err := validResponse.VisitGetArticlesFeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchArticles operation middleware
+func (sh *strictHandler) SearchArticles(w http.ResponseWriter, r *http.Request, params SearchArticlesParams) {
+	var request SearchArticlesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchArticles(ctx, request.(SearchArticlesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchArticles")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchArticlesResponseObject); ok {
+		if err := validResponse.VisitSearchArticlesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteArticle operation middleware
+func (sh *strictHandler) DeleteArticle(w http.ResponseWriter, r *http.Request, slug string) {
+	var request DeleteArticleRequestObject
+
+	request.Slug = slug
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArticle(ctx, request.(DeleteArticleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArticle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArticleResponseObject); ok {
+		if err := validResponse.VisitDeleteArticleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArticle operation middleware
+func (sh *strictHandler) GetArticle(w http.ResponseWriter, r *http.Request, slug string, params GetArticleParams) {
+	var request GetArticleRequestObject
+
+	request.Slug = slug
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArticle(ctx, request.(GetArticleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArticle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArticleResponseObject); ok {
+		if err := validResponse.VisitGetArticleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateArticle operation middleware
+func (sh *strictHandler) UpdateArticle(w http.ResponseWriter, r *http.Request, slug string, params UpdateArticleParams) {
+	var request UpdateArticleRequestObject
+
+	request.Slug = slug
+	request.Params = params
+
+	var body UpdateArticleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArticle(ctx, request.(UpdateArticleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArticle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateArticleResponseObject); ok {
+		if err := validResponse.VisitUpdateArticleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArticleComments operation middleware
+func (sh *strictHandler) GetArticleComments(w http.ResponseWriter, r *http.Request, slug string, params GetArticleCommentsParams) {
+	var request GetArticleCommentsRequestObject
+
+	request.Slug = slug
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArticleComments(ctx, request.(GetArticleCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArticleComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArticleCommentsResponseObject); ok {
+		if err := validResponse.VisitGetArticleCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateArticleComment operation middleware
+func (sh *strictHandler) CreateArticleComment(w http.ResponseWriter, r *http.Request, slug string) {
+	var request CreateArticleCommentRequestObject
+
+	request.Slug = slug
+
+	var body CreateArticleCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateArticleComment(ctx, request.(CreateArticleCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateArticleComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateArticleCommentResponseObject); ok {
+		if err := validResponse.VisitCreateArticleCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteArticleComment operation middleware
+func (sh *strictHandler) DeleteArticleComment(w http.ResponseWriter, r *http.Request, slug string, id int) {
+	var request DeleteArticleCommentRequestObject
+
+	request.Slug = slug
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArticleComment(ctx, request.(DeleteArticleCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArticleComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArticleCommentResponseObject); ok {
+		if err := validResponse.VisitDeleteArticleCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateArticleComment operation middleware
+func (sh *strictHandler) UpdateArticleComment(w http.ResponseWriter, r *http.Request, slug string, id int) {
+	var request UpdateArticleCommentRequestObject
+
+	request.Slug = slug
+	request.Id = id
+
+	var body UpdateArticleCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArticleComment(ctx, request.(UpdateArticleCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArticleComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateArticleCommentResponseObject); ok {
+		if err := validResponse.VisitUpdateArticleCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteArticleFavorite operation middleware
+func (sh *strictHandler) DeleteArticleFavorite(w http.ResponseWriter, r *http.Request, slug string) {
+	var request DeleteArticleFavoriteRequestObject
+
+	request.Slug = slug
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArticleFavorite(ctx, request.(DeleteArticleFavoriteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArticleFavorite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArticleFavoriteResponseObject); ok {
+		if err := validResponse.VisitDeleteArticleFavoriteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateArticleFavorite operation middleware
+func (sh *strictHandler) CreateArticleFavorite(w http.ResponseWriter, r *http.Request, slug string) {
+	var request CreateArticleFavoriteRequestObject
+
+	request.Slug = slug
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateArticleFavorite(ctx, request.(CreateArticleFavoriteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateArticleFavorite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateArticleFavoriteResponseObject); ok {
+		if err := validResponse.VisitCreateArticleFavoriteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UnpublishArticle operation middleware
+func (sh *strictHandler) UnpublishArticle(w http.ResponseWriter, r *http.Request, slug string) {
+	var request UnpublishArticleRequestObject
+
+	request.Slug = slug
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnpublishArticle(ctx, request.(UnpublishArticleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnpublishArticle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnpublishArticleResponseObject); ok {
+		if err := validResponse.VisitUnpublishArticleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PublishArticle operation middleware
+func (sh *strictHandler) PublishArticle(w http.ResponseWriter, r *http.Request, slug string, params PublishArticleParams) {
+	var request PublishArticleRequestObject
+
+	request.Slug = slug
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PublishArticle(ctx, request.(PublishArticleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PublishArticle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PublishArticleResponseObject); ok {
+		if err := validResponse.VisitPublishArticleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreArticle operation middleware
+func (sh *strictHandler) RestoreArticle(w http.ResponseWriter, r *http.Request, slug string) {
+	var request RestoreArticleRequestObject
+
+	request.Slug = slug
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreArticle(ctx, request.(RestoreArticleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreArticle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreArticleResponseObject); ok {
+		if err := validResponse.VisitRestoreArticleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArticleRevisions operation middleware
+func (sh *strictHandler) GetArticleRevisions(w http.ResponseWriter, r *http.Request, slug string) {
+	var request GetArticleRevisionsRequestObject
+
+	request.Slug = slug
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArticleRevisions(ctx, request.(GetArticleRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArticleRevisions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArticleRevisionsResponseObject); ok {
+		if err := validResponse.VisitGetArticleRevisionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArticleRevision operation middleware
+func (sh *strictHandler) GetArticleRevision(w http.ResponseWriter, r *http.Request, slug string, number int) {
+	var request GetArticleRevisionRequestObject
+
+	request.Slug = slug
+	request.Number = number
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArticleRevision(ctx, request.(GetArticleRevisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArticleRevision")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArticleRevisionResponseObject); ok {
+		if err := validResponse.VisitGetArticleRevisionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArticleRevisionDiff operation middleware
+func (sh *strictHandler) GetArticleRevisionDiff(w http.ResponseWriter, r *http.Request, slug string, number int, params GetArticleRevisionDiffParams) {
+	var request GetArticleRevisionDiffRequestObject
+
+	request.Slug = slug
+	request.Number = number
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArticleRevisionDiff(ctx, request.(GetArticleRevisionDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArticleRevisionDiff")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArticleRevisionDiffResponseObject); ok {
+		if err := validResponse.VisitGetArticleRevisionDiffResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetArticles operation middleware
-func (sh *strictHandler) GetArticles(w http.ResponseWriter, r *http.Request, params GetArticlesParams) {
-	var request GetArticlesRequestObject
+// RestoreArticleRevision operation middleware
+func (sh *strictHandler) RestoreArticleRevision(w http.ResponseWriter, r *http.Request, slug string, number int, params RestoreArticleRevisionParams) {
+	var request RestoreArticleRevisionRequestObject
 
+	request.Slug = slug
+	request.Number = number
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArticles(ctx, request.(GetArticlesRequestObject))
+		return sh.ssi.RestoreArticleRevision(ctx, request.(RestoreArticleRevisionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArticles")
+		handler = middleware(handler, "RestoreArticleRevision")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArticlesResponseObject); ok {
-		if err := validResponse.VisitGetArticlesResponse(w); err != nil {
+	} else if validResponse, ok := response.(RestoreArticleRevisionResponseObject); ok {
+		if err := validResponse.VisitRestoreArticleRevisionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1701,11 +5488,11 @@ func (sh *strictHandler) GetArticles(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// CreateArticle operation middleware
-func (sh *strictHandler) CreateArticle(w http.ResponseWriter, r *http.Request) {
-	var request CreateArticleRequestObject
+// PreviewMarkdown operation middleware
+func (sh *strictHandler) PreviewMarkdown(w http.ResponseWriter, r *http.Request) {
+	var request PreviewMarkdownRequestObject
 
-	var body CreateArticleJSONRequestBody
+	var body PreviewMarkdownJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1713,18 +5500,18 @@ func (sh *strictHandler) CreateArticle(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateArticle(ctx, request.(CreateArticleRequestObject))
+		return sh.ssi.PreviewMarkdown(ctx, request.(PreviewMarkdownRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateArticle")
+		handler = middleware(handler, "PreviewMarkdown")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateArticleResponseObject); ok {
-		if err := validResponse.VisitCreateArticleResponse(w); err != nil {
+	} else if validResponse, ok := response.(PreviewMarkdownResponseObject); ok {
+		if err := validResponse.VisitPreviewMarkdownResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1732,25 +5519,25 @@ func (sh *strictHandler) CreateArticle(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetArticlesFeed operation middleware
-func (sh *strictHandler) GetArticlesFeed(w http.ResponseWriter, r *http.Request, params GetArticlesFeedParams) {
-	var request GetArticlesFeedRequestObject
+// GetProfileByUsername operation middleware
+func (sh *strictHandler) GetProfileByUsername(w http.ResponseWriter, r *http.Request, username string) {
+	var request GetProfileByUsernameRequestObject
 
-	request.Params = params
+	request.Username = username
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArticlesFeed(ctx, request.(GetArticlesFeedRequestObject))
+		return sh.ssi.GetProfileByUsername(ctx, request.(GetProfileByUsernameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArticlesFeed")
+		handler = middleware(handler, "GetProfileByUsername")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArticlesFeedResponseObject); ok {
-		if err := validResponse.VisitGetArticlesFeedResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProfileByUsernameResponseObject); ok {
+		if err := validResponse.VisitGetProfileByUsernameResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1758,25 +5545,25 @@ func (sh *strictHandler) GetArticlesFeed(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteArticle operation middleware
-func (sh *strictHandler) DeleteArticle(w http.ResponseWriter, r *http.Request, slug string) {
-	var request DeleteArticleRequestObject
+// UnfollowUserByUsername operation middleware
+func (sh *strictHandler) UnfollowUserByUsername(w http.ResponseWriter, r *http.Request, username string) {
+	var request UnfollowUserByUsernameRequestObject
 
-	request.Slug = slug
+	request.Username = username
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArticle(ctx, request.(DeleteArticleRequestObject))
+		return sh.ssi.UnfollowUserByUsername(ctx, request.(UnfollowUserByUsernameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArticle")
+		handler = middleware(handler, "UnfollowUserByUsername")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteArticleResponseObject); ok {
-		if err := validResponse.VisitDeleteArticleResponse(w); err != nil {
+	} else if validResponse, ok := response.(UnfollowUserByUsernameResponseObject); ok {
+		if err := validResponse.VisitUnfollowUserByUsernameResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1784,25 +5571,25 @@ func (sh *strictHandler) DeleteArticle(w http.ResponseWriter, r *http.Request, s
 	}
 }
 
-// GetArticle operation middleware
-func (sh *strictHandler) GetArticle(w http.ResponseWriter, r *http.Request, slug string) {
-	var request GetArticleRequestObject
+// FollowUserByUsername operation middleware
+func (sh *strictHandler) FollowUserByUsername(w http.ResponseWriter, r *http.Request, username string) {
+	var request FollowUserByUsernameRequestObject
 
-	request.Slug = slug
+	request.Username = username
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArticle(ctx, request.(GetArticleRequestObject))
+		return sh.ssi.FollowUserByUsername(ctx, request.(FollowUserByUsernameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArticle")
+		handler = middleware(handler, "FollowUserByUsername")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArticleResponseObject); ok {
-		if err := validResponse.VisitGetArticleResponse(w); err != nil {
+	} else if validResponse, ok := response.(FollowUserByUsernameResponseObject); ok {
+		if err := validResponse.VisitFollowUserByUsernameResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1810,32 +5597,26 @@ func (sh *strictHandler) GetArticle(w http.ResponseWriter, r *http.Request, slug
 	}
 }
 
-// UpdateArticle operation middleware
-func (sh *strictHandler) UpdateArticle(w http.ResponseWriter, r *http.Request, slug string) {
-	var request UpdateArticleRequestObject
-
-	request.Slug = slug
+// GetProfileFollowers operation middleware
+func (sh *strictHandler) GetProfileFollowers(w http.ResponseWriter, r *http.Request, username string, params GetProfileFollowersParams) {
+	var request GetProfileFollowersRequestObject
 
-	var body UpdateArticleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Username = username
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateArticle(ctx, request.(UpdateArticleRequestObject))
+		return sh.ssi.GetProfileFollowers(ctx, request.(GetProfileFollowersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateArticle")
+		handler = middleware(handler, "GetProfileFollowers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateArticleResponseObject); ok {
-		if err := validResponse.VisitUpdateArticleResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProfileFollowersResponseObject); ok {
+		if err := validResponse.VisitGetProfileFollowersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1843,25 +5624,26 @@ func (sh *strictHandler) UpdateArticle(w http.ResponseWriter, r *http.Request, s
 	}
 }
 
-// GetArticleComments operation middleware
-func (sh *strictHandler) GetArticleComments(w http.ResponseWriter, r *http.Request, slug string) {
-	var request GetArticleCommentsRequestObject
+// GetProfileFollowing operation middleware
+func (sh *strictHandler) GetProfileFollowing(w http.ResponseWriter, r *http.Request, username string, params GetProfileFollowingParams) {
+	var request GetProfileFollowingRequestObject
 
-	request.Slug = slug
+	request.Username = username
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArticleComments(ctx, request.(GetArticleCommentsRequestObject))
+		return sh.ssi.GetProfileFollowing(ctx, request.(GetProfileFollowingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArticleComments")
+		handler = middleware(handler, "GetProfileFollowing")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArticleCommentsResponseObject); ok {
-		if err := validResponse.VisitGetArticleCommentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProfileFollowingResponseObject); ok {
+		if err := validResponse.VisitGetProfileFollowingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1869,32 +5651,23 @@ func (sh *strictHandler) GetArticleComments(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// CreateArticleComment operation middleware
-func (sh *strictHandler) CreateArticleComment(w http.ResponseWriter, r *http.Request, slug string) {
-	var request CreateArticleCommentRequestObject
-
-	request.Slug = slug
-
-	var body CreateArticleCommentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetTags operation middleware
+func (sh *strictHandler) GetTags(w http.ResponseWriter, r *http.Request) {
+	var request GetTagsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateArticleComment(ctx, request.(CreateArticleCommentRequestObject))
+		return sh.ssi.GetTags(ctx, request.(GetTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateArticleComment")
+		handler = middleware(handler, "GetTags")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateArticleCommentResponseObject); ok {
-		if err := validResponse.VisitCreateArticleCommentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagsResponseObject); ok {
+		if err := validResponse.VisitGetTagsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1902,26 +5675,25 @@ func (sh *strictHandler) CreateArticleComment(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// DeleteArticleComment operation middleware
-func (sh *strictHandler) DeleteArticleComment(w http.ResponseWriter, r *http.Request, slug string, id int) {
-	var request DeleteArticleCommentRequestObject
+// GetCurrentUser operation middleware
+func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request, params GetCurrentUserParams) {
+	var request GetCurrentUserRequestObject
 
-	request.Slug = slug
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArticleComment(ctx, request.(DeleteArticleCommentRequestObject))
+		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArticleComment")
+		handler = middleware(handler, "GetCurrentUser")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteArticleCommentResponseObject); ok {
-		if err := validResponse.VisitDeleteArticleCommentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
+		if err := validResponse.VisitGetCurrentUserResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1929,25 +5701,32 @@ func (sh *strictHandler) DeleteArticleComment(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// DeleteArticleFavorite operation middleware
-func (sh *strictHandler) DeleteArticleFavorite(w http.ResponseWriter, r *http.Request, slug string) {
-	var request DeleteArticleFavoriteRequestObject
+// UpdateCurrentUser operation middleware
+func (sh *strictHandler) UpdateCurrentUser(w http.ResponseWriter, r *http.Request, params UpdateCurrentUserParams) {
+	var request UpdateCurrentUserRequestObject
 
-	request.Slug = slug
+	request.Params = params
+
+	var body UpdateCurrentUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArticleFavorite(ctx, request.(DeleteArticleFavoriteRequestObject))
+		return sh.ssi.UpdateCurrentUser(ctx, request.(UpdateCurrentUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArticleFavorite")
+		handler = middleware(handler, "UpdateCurrentUser")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteArticleFavoriteResponseObject); ok {
-		if err := validResponse.VisitDeleteArticleFavoriteResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateCurrentUserResponseObject); ok {
+		if err := validResponse.VisitUpdateCurrentUserResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1955,25 +5734,25 @@ func (sh *strictHandler) DeleteArticleFavorite(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// CreateArticleFavorite operation middleware
-func (sh *strictHandler) CreateArticleFavorite(w http.ResponseWriter, r *http.Request, slug string) {
-	var request CreateArticleFavoriteRequestObject
+// GetDraftArticles operation middleware
+func (sh *strictHandler) GetDraftArticles(w http.ResponseWriter, r *http.Request, params GetDraftArticlesParams) {
+	var request GetDraftArticlesRequestObject
 
-	request.Slug = slug
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateArticleFavorite(ctx, request.(CreateArticleFavoriteRequestObject))
+		return sh.ssi.GetDraftArticles(ctx, request.(GetDraftArticlesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateArticleFavorite")
+		handler = middleware(handler, "GetDraftArticles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateArticleFavoriteResponseObject); ok {
-		if err := validResponse.VisitCreateArticleFavoriteResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetDraftArticlesResponseObject); ok {
+		if err := validResponse.VisitGetDraftArticlesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1981,25 +5760,23 @@ func (sh *strictHandler) CreateArticleFavorite(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetProfileByUsername operation middleware
-func (sh *strictHandler) GetProfileByUsername(w http.ResponseWriter, r *http.Request, username string) {
-	var request GetProfileByUsernameRequestObject
-
-	request.Username = username
+// Logout operation middleware
+func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var request LogoutRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfileByUsername(ctx, request.(GetProfileByUsernameRequestObject))
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfileByUsername")
+		handler = middleware(handler, "Logout")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProfileByUsernameResponseObject); ok {
-		if err := validResponse.VisitGetProfileByUsernameResponse(w); err != nil {
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2007,25 +5784,23 @@ func (sh *strictHandler) GetProfileByUsername(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// UnfollowUserByUsername operation middleware
-func (sh *strictHandler) UnfollowUserByUsername(w http.ResponseWriter, r *http.Request, username string) {
-	var request UnfollowUserByUsernameRequestObject
-
-	request.Username = username
+// LogoutAll operation middleware
+func (sh *strictHandler) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	var request LogoutAllRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UnfollowUserByUsername(ctx, request.(UnfollowUserByUsernameRequestObject))
+		return sh.ssi.LogoutAll(ctx, request.(LogoutAllRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UnfollowUserByUsername")
+		handler = middleware(handler, "LogoutAll")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UnfollowUserByUsernameResponseObject); ok {
-		if err := validResponse.VisitUnfollowUserByUsernameResponse(w); err != nil {
+	} else if validResponse, ok := response.(LogoutAllResponseObject); ok {
+		if err := validResponse.VisitLogoutAllResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2033,25 +5808,23 @@ func (sh *strictHandler) UnfollowUserByUsername(w http.ResponseWriter, r *http.R
 	}
 }
 
-// FollowUserByUsername operation middleware
-func (sh *strictHandler) FollowUserByUsername(w http.ResponseWriter, r *http.Request, username string) {
-	var request FollowUserByUsernameRequestObject
-
-	request.Username = username
+// GetPersonalAccessTokens operation middleware
+func (sh *strictHandler) GetPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	var request GetPersonalAccessTokensRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.FollowUserByUsername(ctx, request.(FollowUserByUsernameRequestObject))
+		return sh.ssi.GetPersonalAccessTokens(ctx, request.(GetPersonalAccessTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FollowUserByUsername")
+		handler = middleware(handler, "GetPersonalAccessTokens")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(FollowUserByUsernameResponseObject); ok {
-		if err := validResponse.VisitFollowUserByUsernameResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPersonalAccessTokensResponseObject); ok {
+		if err := validResponse.VisitGetPersonalAccessTokensResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2059,23 +5832,30 @@ func (sh *strictHandler) FollowUserByUsername(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetTags operation middleware
-func (sh *strictHandler) GetTags(w http.ResponseWriter, r *http.Request) {
-	var request GetTagsRequestObject
+// CreatePersonalAccessToken operation middleware
+func (sh *strictHandler) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	var request CreatePersonalAccessTokenRequestObject
+
+	var body CreatePersonalAccessTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTags(ctx, request.(GetTagsRequestObject))
+		return sh.ssi.CreatePersonalAccessToken(ctx, request.(CreatePersonalAccessTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTags")
+		handler = middleware(handler, "CreatePersonalAccessToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTagsResponseObject); ok {
-		if err := validResponse.VisitGetTagsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePersonalAccessTokenResponseObject); ok {
+		if err := validResponse.VisitCreatePersonalAccessTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2083,23 +5863,25 @@ func (sh *strictHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetCurrentUser operation middleware
-func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
-	var request GetCurrentUserRequestObject
+// DeletePersonalAccessToken operation middleware
+func (sh *strictHandler) DeletePersonalAccessToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeletePersonalAccessTokenRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
+		return sh.ssi.DeletePersonalAccessToken(ctx, request.(DeletePersonalAccessTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCurrentUser")
+		handler = middleware(handler, "DeletePersonalAccessToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
-		if err := validResponse.VisitGetCurrentUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePersonalAccessTokenResponseObject); ok {
+		if err := validResponse.VisitDeletePersonalAccessTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2107,30 +5889,25 @@ func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// UpdateCurrentUser operation middleware
-func (sh *strictHandler) UpdateCurrentUser(w http.ResponseWriter, r *http.Request) {
-	var request UpdateCurrentUserRequestObject
+// GetTrashedArticles operation middleware
+func (sh *strictHandler) GetTrashedArticles(w http.ResponseWriter, r *http.Request, params GetTrashedArticlesParams) {
+	var request GetTrashedArticlesRequestObject
 
-	var body UpdateCurrentUserJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCurrentUser(ctx, request.(UpdateCurrentUserRequestObject))
+		return sh.ssi.GetTrashedArticles(ctx, request.(GetTrashedArticlesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCurrentUser")
+		handler = middleware(handler, "GetTrashedArticles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCurrentUserResponseObject); ok {
-		if err := validResponse.VisitUpdateCurrentUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTrashedArticlesResponseObject); ok {
+		if err := validResponse.VisitGetTrashedArticlesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2200,51 +5977,147 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// RefreshToken operation middleware
+func (sh *strictHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var request RefreshTokenRequestObject
+
+	var body RefreshTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshToken(ctx, request.(RefreshTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefreshTokenResponseObject); ok {
+		if err := validResponse.VisitRefreshTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbW3PbNhb+KxjsznQ3I0tOtk96c524k92km0ns6UOSB4g8otCQAAuAdlSP/vsObiRI",
-	"ghIlM4m3TV5ikcDBuXznggPwHie8KDkDpiRe3uOSCFKAAmF+5bSg6o1+pH+lIBNBS0U5w0t8vQHEqmIF",
-	"QiK+RlRBIZHiSICqBJvjGaZ62O8ViC2eYUYKwEtLEc+wTDZQEEt1Tapc4eWz8xkuKKNFVeDl0xlW21LP",
-	"oExBBgLvdjPM12sJhxlq8SM/0RKtYM0FIKmIUJRl+nnC8xwShdQGkABZ5QpJUEN825VbjNe8nkd43c2w",
-	"gN8rkOonnlIw2nzFM8puJIi39o1+lnCmgJk/SVnmNCFamsVvUot0H6xWCl6CUI5UJUHo//8uYI2X+G+L",
-	"xooLO0cu6uWw54YKSPHyvZ39seaar36DRFmm2yq9FJACU5TkRpWVBBxSUqKC3Qz/AncXQtEkh4cLRiyh",
-	"Q7I1S/aE8xTGyOdoGDgIIGpIvEteFMDUw8VLLKER4rkle+J5CqPMZ8eiLa/QHWHqoJxfD5xusdOh+RwU",
-	"obkJPdqDGdxpeAobgTIqFYiYkDdlShR8bbi2Vp0KsZUhOizk1zNms97p9tSzUeqMWks3RxcK5UCkQk+e",
-	"cAZPnqA1hTxFVCK/zLyvAsOELDmTVogXRam2//301j3r545fdD6w+tnN8M/AQNDkhRBcHKW6fUoKib7m",
-	"KeRRLTD4XEKiIEVgVt/N8OsqV7TMPXxkKMXDYGv+NokyMqRSG37Q8m8EX1MN6Rm2gSW9MMysuSiIwkus",
-	"jXimaKFx6jAglaAsw13Z7/vv1+SWC6q0Weu3K85zICx8LS95ZXXQTcMzLPMqi9JWJHtFrWfUGugPsg+I",
-	"EGRrflNlnb030sL1COm7McCqO1RjWz+hNnqiOzkbqTyrIWN9L+wL6IExqNF46JK4O3WMy3tgo5pKgHaX",
-	"u6ZAu0uZbbTvQ3Wde7sKiudieZy09azdDDv/mUDI0nniWIftiOKnj5EkcPp3lGV5k06nCkyHpJgik1rW",
-	"PfYaYepabyrcjUbbAyo9J0zSAPeaZFP4jiKZPCZGdmQw08cIoNnV1G6YjYT0Dxv1u+kxeKtHmxLnwUKO",
-	"qnEeXN2Y2Y6cXu2iwfoDU++Kp9uobb7n5ElystHv107Nl030eETwoGncpl9S1zQ9qKx+cd1Tm6mnI4Wu",
-	"186JEc5Mj4SA9ii3eIz1pk3TZ7kgNI+yVBIp77hIW9quHx5StqUbUInxFbRYBnV2dPSY1MNjluh6pp07",
-	"IN+giw3IN870dSflCxp0ZlKP7U3eH2vtYHKM+zdNJdnRCuXxlMDznN/pH9GUQAuSDQTo0TLopcOFPNUD",
-	"orT7LhPCeBiRAzzE8TCk0WGcDCszRNCRmu7z/MW5VfwTsElA4eHtAWEp7wWGrkEgqQRV23c6XVrxrj1L",
-	"7dLtigtEkgSkNAcHG0Cl4Mr2aC7evEQCJK9EAnJm+qxFJRXakFtAAhKgt5Aiggi6JTlN0b9/vUaGP0TW",
-	"CkTdqdSUuUA5zzL9J2VzdL2hMhhvyKoNMLQCVElI0VrzlecBNzUnaLVFGg6GlkKUoVtKDOs/XLj62RTG",
-	"P6ANkBTE/AP7wC6C1ahEmc6oOuNqYnqqlnW1RUDVpsO5Jr7Q6pZtIYIXi1xnObPO9QZQ7cjIxjor3gr0",
-	"pGE20VLPRwghYyr02fybb+2/+R/mnx3wgflzHDu1OchpUW4CKynpf2BrS3fK1tzvJkhi0oOb/BZI/isX",
-	"uYmhItfklSrlcrEQQPI7/eYs5YmcM1A5XW/npCwXONKXZ2lFlVFpypNKJyHPT04TcLsZt+jrl9folXva",
-	"XZaXwKzR51xkCzdZLl6/vA7iVMM3CpbGM3wLQlqWns7P5+d6iqZISoqX+F/z8/lTkzfUxjjIImwbZqD6",
-	"vvIzKFRwqQz2maobOyjL+Yrk+XaObiQgc7aGmqNGpDha09x6hKxyJedI20njkBvaRHu4jkVGTS9Tu9ZF",
-	"030Kzy3f91zY0tZAJtnAAZ990+wQe6FnmKitXdE/fMT558ASdY170ir1vgLxNSL2sOPgkuHGZO+qsa1E",
-	"o9NFePA6Ynhwbrz72OnFPzs/H9q71OMWgz3v3Qz/eP70MIFup+DHZ88OT2r1/U2WqIqCiK1D9hCo7eZO",
-	"Aw/XiPyoMzKXER+5NDsbRJgn1GC9Tm5drNs5vo4Jj5a3w1IFp8+L/hHtrmeWEVqNt/u+sk1c5jZ+7nL2",
-	"+48aZo21ejqOmmiGP58lPIUM2JlT15kuCc+8vwZdxjr2LdZgex/HBcC14AWyOVJXCTYBDkdD40AjgBEE",
-	"wSsw7zuB8Ltfn4qhmMdHrRh3/xZm7mVeZTsLlxxU5ADyuXl+XFSwc5qosDcHvsurzB+Wk+YQ2fHjsodO",
-	"9U3ycI2r9tnqvjxyEiS6Z7OPDwk92wwF/MGQ0DMr42qMU59o1MzcVPpmFh3OEhMk4cNWKKuIFewu/Dj/",
-	"ancPTjNFfUljMmscmfmjF15205r1cblrz9QTJn8byBfhifKg02sk+IF2tx4B34jtjT8JPwmBG9K+/pWB",
-	"ajH1LePE4FH/tyzyBywWYKi2x+Ea31MbNP+4it/3qacAQBJh7RuGp/7lygfEpu7R/ePdmAwAI4qyQ5Eq",
-	"Ce4PDEaqxT1NRxWgp0O2VY5OCdk0wtpEkO31W14+9+wkkcuz+6plc0p5cOXmItWfvFg+BuFR5PrW1T7Q",
-	"3jA/6gFQvfILTYHVqubocVbgj6xUi9kvgIg3zb5ce3USAlr5dVIEfLf/ePtfjba+jhDujqJc3Pu2925v",
-	"AU6QmxF0zJ315FYqKMaV4e48/KftjT9RPAATP84v5rnYtzOvGtpfGBvdK6ffstiuLRRY3V8/GLT5wrXd",
-	"9ucFM8ZbfbVFgYI7u3w3Vlvt4Tbu5ALfIPwrW3xcHgjtFUXDYPwfbemrL2Tn71YeGe0P2lh7vL9xPNxX",
-	"IZkc2UY1t4pPUWbr9vREwU5ZZrzUhjcrsr9/PCSytBuSSghgKt+aKxaQnlHmNdkT/NKOvZHuK7hj5W9d",
-	"rH6cZzNOHV0wme/ICEsNQIApdwf8UGc4teGDMnshhXJmNi6dRWL94a6qT+rPht/q7f6EBnM92WNtdqj1",
-	"YS7w1S5kL/dGk8Rbd10JkfpL0YEtwal27Hw9e9Lxes+IJ9ljaq3au1vDujUXmI23wGcqzef1Uf2acaeo",
-	"tvfd/P+dh9R+8CLUEMqdRqaymHFGcesrmfZVMVLSeX1LbU65fmBO8d3q9X2zi+ZrvPrZZfPNWv2s2Z4F",
-	"D5vPDupH7tOe+veQlLuPu/8FAAD//2gZabyOQgAA",
+	"H4sIAAAAAAAC/+w9XXPcOHJ/BcWk6pINpZF3XblEbzrbunNi77q8cvbh1rWFIXuGOHMALgBKnnPNf0/h",
+	"kyAJcjgzlCx77RePSBDobjS6G92NxqckY5uKUaBSJJefkgJwDlz/fHGD1+r/HETGSSUJo8ll8rPkjK4R",
+	"UEnkFkm8RmyFZAGIg6gYFZDqv26BC8Jo8JLVPAO0YmXJ7iBHyy3CqMCicE3uClaqhhUHAVRiNV6KMlZT",
+	"KRCmOVqVeC1c61sCd8ARoVlZ55AnaSKyAjZYASy3FSSXiZCc0HWy2+3SpMIcb0BazLKaC8bfqGd9BG8K",
+	"QBQ+yme6kRuv4nBLWC1QhdcWRfULCYm5gm8lFTTyHKnP2WolQCIiEFlTxiFHdwVQhJEZWL1Yk1ug50ma",
+	"EDXm7zXwbZImFG8U5KbZKE5pQlavscyKESzUBHp62flQv+sqxxIQrqqSgECSpeHjFSalQHdEFujpk+8N",
+	"5K0pLLBAWYHpGnIkCM3gHP1Ey21s2jUERCDFY5hDniJsP3Ut7Pw2hI7Ncs5AIMqkhi2A1dPPcG1DwJer",
+	"M02cvST8kVGYSkaMMpwVkDsk0xbbKzQx+uHiKborSAmI6PnPas6ByjFAFQiToC3Jhsgxpq03S+CadkTC",
+	"Rk0s4iBrPshnusfWqDmscF3K5PL7izTZEEo29Sa5fJI6cAiVsAau4TFcvhegFjziA6nQElaM26VD6Fo9",
+	"z1hZQiYdRetSIgFyCG4zcgtwD+tFBNZdmnD4vQYh/8JyAloGvGJrQt8J4G/NG/UsY1QC1T/14si0EFr8",
+	"QyiUPgWjVZxVwKXtqhbA1f//ymGVXCb/smhE6sJ8IxZ+uMRBQzjkyeXfzdfvPdRs+Q/IpAG6TdJnHHIl",
+	"dnGpSVkLSMKeJK9hlyavMf+Qszv6RgksuDsduyXLt3GGDLHQraZg4eAz3EnNauhh8SPcXXFJshJORwCb",
+	"jvbNUDNkDznXwxT8bB+aqTlgCQPoPWObDVB5OnqZ6WgCenbIHnquh0lMaNqiLavRHaZyL55vgAtGcXmV",
+	"ZSDEDfsA9HScpepmAsaRwXvYm76m4O56Q1h3h/SXewnwcDLGDna8hHkOUit/q30p3Ckpw81SXRMh44v1",
+	"Law4iGKmuZ2CaTji8ejaXpp5hI/GOIkh+U5bHA8tlFqjziWXjPE0jORDi6bWqLNLp33YPtz6bMY7nmfV",
+	"1yi369Rjd46uJCoBC4m++45R+O47tCJQ5soEdcOc90mggTDmq0bCc/ctUQbuc7JavbXvTyBNTlarfaSJ",
+	"jNyjke5nkhgjq5WWYXcM2SWCuO1ZJLvU4fkzYJ4VM2BoB9G/tak7EV0PgDK5dx4zzDneqr9dv8/UJqlv",
+	"Y0smcRlY2a452qjdhLasC0BCD5IinHEmBMJlqXeuIoka9RHxolq2ITlA3gTAYAuKwuwZo6uSZIetuDGK",
+	"/hUocJK94Jzx1yyHUmMT9ldxtixh8x+H9fvGfGU1Y1zmWFR2afJiU8ntTx9Clmo3/pEhh+4uTa4ZX5I8",
+	"N6bMF06GBpddmoRgfAW4vaPwsYJMQo5AoxTbZ50sRdQW6m9yU07bbOmW06wctcmCHG0sxBr6upSkKqEj",
+	"d8UMaDSS9kBp6IDoS8IO+s0IkzacFtW4KugQQtyXNug0qWXB+ATGXBFl9qXOCBrSBD96HeAaao8pB+3h",
+	"S1EOJSjuZRSEsnKdz7SrANLEbGXyKz3IivENlsllomyMM0k2kKRd1kwT2/lVBK7n6pV2O5INGB+a5Fgo",
+	"J5qlUpJOHiXo+FP//QrfMk4k5MHbJWMlYBq+bijYx50Dzgld3ygIeqi8EJJsFGmQbaZxShGhaENoLaMK",
+	"NU1EWa+j8Eq8LOGn1TPDY9MXy037uxdU8m3MdpB4/YoI2eq3D0T3IyLNnqTX0tiZB/HFHeP5ILW7poZZ",
+	"ECH/tac8nODebHbXhyV7QwSHWYhHCGB77vuT05cz92SsrUgpgYtJ1lqaNGGK/a4B33JWK68rXFsy1e7G",
+	"5pCpbn4nLxO/m+xP1CxU8wAdRCX/VUCliI9qDoppp8Z0ekU9ZXv0sB3iIApUMR9amx5G681Bg+NmOk0q",
+	"C8J06jWaepRivuPDaOa+Ug5FJq9ZTfOvwKr+UQX0NC4Gr9csJytiFHi/5ca+TYPAIkWt+JnydqiXNuiG",
+	"GIUkjUW0Y3DbZgvdRkP7hkPGaE4UENeYlPA1ED1ESodTHUVd5NYQ1dOTMtmjqaaNZskZ1qhl7skrLL6i",
+	"pvnvm1X6M6HrYPczl80/cadzkiPXgO7t5z4yZncz407u4P3bwH7tCPT8Zq3B03un5zIqJpsSJzimLVZZ",
+	"Y5WYJ9Ho2Dy6/yiNf3RszCIYVe8K3Ru8nsWqwWtxyJami4/6fAo6ClzV2ztqdibknzG91HqrWutQwslI",
+	"ToolnBxFUAD/nxH6kH8FkjBVyWgqJ0mnLhEpnEo7xQbwBPqipvYeSLFzyTZhoGgGx9ZAdkna8ob2U4uc",
+	"LxOpZjaPBHKdYYQpkWpBokJuyhSV5AOghWu/qIy7VqeUxZwWj9vRdu8usKpelkQUMV/ezyr3rS4hR7qR",
+	"YXjv2JOFX4iTXXoP6m8TEst6cmDONP7mphty0+ll+7DeOjuBp7rtusbq1Hy3e1mxkBN5kLg0/sP42h3i",
+	"is4c2i4aIrcnrjezE4j43Eb443H/rs2kt/FIvTX6CSuvpzYdfVqxTl5IEahwKloxbgLZeAM+ehoh9oqz",
+	"zQBl2ATe1p/rtulQqkGaxAL3M0R3HqUu4Jh+aAPE6mUZQGM5aVTqUlJVENEmSjkjEBmu1HRzvDbK1GoS",
+	"xYTGLaG94yDQHceVakoo+rW+uPghUxpd/4qSZ2axqt/8jayLkqyLfdjoxidBf7AUnzmaMkkea/bokaaZ",
+	"8rHl43Vxm4z+GIE1Q5owoUCYAyqJkM5fxWQB3Dxm6jMliZYmv00WQDjyRABab3T+EMcrlzSuDZnE2zuQ",
+	"J+97RE1dOtl9mrnHx1v75Lvy5qdVsEa+WuMUfQCohN4NVCXOQDGjIqQslBJNnSzW7wpW5sCNca3sW8oa",
+	"cvaFSQ6VLCK2Mgid3l/CLZTNeQ8NWYouvFz3lnTPjOzLJKUwY6j/UoDiBy880B0WNjfOHlIJh9JvK2aY",
+	"CUsUsnUfPZIP2MqYA5UvI9C8zDvoIlkQgRgFPxlazfT7PHXlR+wykncWrpmuho88VWMrtu+p7i0GUO/E",
+	"sCF1pK9m6ERBu5UdPAb6S3qLS5L7Aypt6MxRkk/RXYmIKtXOyLoD3zwGQHPmo0+zDSZldPgKC6Es3BYD",
+	"+If75t/0G/QSgys46TDd+t1ncBjp2lsKzzQninBhIywQRro9IlRIwHq5WFGsJAaJL8QJm1PRqA+7QW0L",
+	"ldRJvVUtaz59qzqrPRFfs239bL4dmL1BvTQ4ewfJKqal1DYuo6at0qGjH32Q4WNFOIh49pA+jKbznDHa",
+	"AuZIGemIsrvJEze4zEXGqk6E1xkKzua4vONEQrBf9Q9cRNY/qAVw+0fMkBgVdFaQWIAGaHnPYsRgMECs",
+	"fTIm+DgOfRiF71h8Ff69Bncu1p87+SjtOVu8FCbqqF+UWJgXMQQmcdsR5laLQad9Qtpkr2uSn8qb+4WN",
+	"Q7jvLNWvlOEH5So11rI5F6odleb0kpU6oxOvsWhza0iefU6DTnC6b75S9Pb6Gfrzf138Gdnwd9oAWtNS",
+	"B5QUG8AaZ1uTiosMlc0hY7oi65obw77titBjRumoNBCmGbRnjJMzDivgoN7EZtjYFr/pc+XTvYItkyQy",
+	"iY2Tcrp7J00kxxn8RvL4y211EG6dOddvmw2gBXBgel0gP54Pu9el3t/1RW3kJWFxl4cpLcDHfBqmjfog",
+	"7hZxr0e6IBslgKLO18kiVKEQAuN6Dfro4dODbn++XvtoXizA3347DnSrdWywqM+7zw40KxgfM0QK6/An",
+	"FPkoUGQN6n1lv59X4XbTdpUaw+GJsiT+M8pUEj7K/SQwQ9rWqUMlRov2wb35zOzAEO2m+eudu0kZVajL",
+	"oI6Ct34xtU5V5UpBHDbs1tjMmyRtRNgplu0AJQ41WaeamcGZun7XA4Ji2GoaXtuhPXXgwu/DfO/Q7lnY",
+	"gbVwshBz1qATYKbnUZNQKTrIak7kVu3bNgb/m7gBc824TSZxOdIVZ9Icxrl689KXJRGpPvW5qYVEBb4F",
+	"xCEDcquUCcJIq130P7/cWGPI1Gtxh5pVz4yjkq3XRu6oMi5EBO11t7IAipaAaqF81oyb7GwPjYdE1bZR",
+	"/KL7UntcdEuwBv1PVzZfRBtcf0Imyn7+K/2VXgWjqfowQIFr79XSuEQVrsstAiKLDuSq84Uit2gjEbxY",
+	"lMoVkar31kYzm/Bouk7z3UL/Lc5R9OS788luiIS88bsay1Dj9EsBHLTnUKHE9JTiMkUY2XIc2unIauln",
+	"yOMvgN9aKCmj2w2rTZfKnvV60Bl/enaW3qMZpzK6VN8jhJDmNPRR/zvfmn/n/9T/TINf6VC9llbPjSLB",
+	"Fflf2Jp0DEJXzGWIYHPC0X78FnD5C+Ol3jHxUnUvZSUuFwsOuLxTb85ylolzCrIkq+05rqpFEjtwmNdE",
+	"ao7IWVZvXLWkJE1KkoHNULGDvn55g17Zp91hWQXU8Ow54+uF/VgsXr+8CaR8AzcKhk7SxCWVXCZPzi/O",
+	"L9QnqkdckeQy+eH84vyJ3iXKQq/vRXguah2LCf0VJNowrZcyoLLx/a9LtsRluT1H7wQgXQwGNRWdFOuZ",
+	"cxO2dIw4R1cdnks0aFyT6WVuxrpqjMyms+Ty7z0JZPpe6mpXKeJQgdpwyGDc5RYJuAWOS9VGDJStkXjd",
+	"qlkz3TE65O72FGrEHqZbZEWTVf7jEPUrD/kaQAmm2yB+Yv7CZRlxcfRBfGGSX0LzQygInRTv09Kmy0yh",
+	"pG16MxtBmzk2cQ70b05//fveGTdfDIHq4ybzguljduZUnYI2gHkAljDoN1JpajAs5+fRKxGpeU0rUx3i",
+	"sB6R2ODOObAyVUSa8aeFOaYDZctLTYTnL7r1vQDk4k+HUMkFau6NSg6oiVSyzeejEs+Bd7YlIrVGDuEt",
+	"170pR8e4KkQDQupIpApMCnmOjEMxiP+KuqoYl67WnmCmtthyq7tJw2Jz6p35ssI+S1L6un0DlFBfDchJ",
+	"A18gKv0DA26SJkqv/RauP/3AOpaj4eddGvclNbpqEVZgm9A8KCA3oXVYJHH3vlMp5PuLiyFfl2+3GDxb",
+	"vUuTpxdP9nfQza9++v33+z9q1R7Qe416s8F8aw2MIdvC5Dso/e9LVyTv1caPicGwltpO244ak8Nvkbom",
+	"h/nmyke3m5J022Gsgqp1i35RtF1vWiZQNX725eg5ufjv/R+FNTKOnES7YdT2md0q9iI173fvw+nuTVJ0",
+	"jtPk41nGclgDPbP0PlPuhjOnwINMdW/DLlYA+eGGrPZCma2a2qyajcywVasX7ATOCozZa9DvOwbtNzky",
+	"hxyJsWCH5f4KEyc9Lm5aLGar5Qwx2XVdlmfKEWnL6iB2axNgbAJa0FyrTp0X09e8oPgU0w867ZILOWHz",
+	"ZNIfp+6fTGvD4KnT0wL9XjOlrauCY6EA+emthvLMZc0j5W4bsqp/7xWz6hQDfQV0LYuwdOnD6NWjGDxe",
+	"j+pRcHf6qc3gdjLDAM0eNv6ksgl3hn9LkDBQJeRAZWq+aZTpOAeW9brD+Uq8WngshylHRWDtmRTIYR7r",
+	"ctVRE9+tF3W8Ev5h/0etCk1PL57u/8IfNn9Qtd1jhyHTbFD3Np+mPtNI7cCQT/30bKD3DsutTos0ND3I",
+	"eXQk561BzsR2+6VTr8j1caw6eFRwlyY/GJ7tZx44lFW+JQeFo83eVXiaitlqGwm8f1b/FTO7wH7Hb7As",
+	"ujStaa7rr/uK28hScphyOw34tFXgSxN85pXTl8dtdh/cxNSRlfLOloE/ROy2w5rHMb8vyPlQ/N/j/QP3",
+	"XdHSr7u5F9GjFfxPJrBvpFbGg+qMHi/PuNUzBswiLEE0qHmahHrROiSqo4WBVgKcFe5t604MJcJcjrgN",
+	"ZzHtMpMFbNEdcECKABKouWpC257acJaFz6PDlUnfHEuvTxEu1UUeJu3f5AwQ7sY+SAm6Ok9HyYMCt8v1",
+	"rkG2yDafnBhxRA7SSLIKVbUUZmuk3+mjvd5laLf5lmhHeSXVGOqFovZMDknvdnQOSf/AeyYlq75qp2Ov",
+	"+NjxIvax6fsBqRKIPL8i93swAynET/Bn2hFnEQFZBLQ5d2mHu107FdGP0v3x6jVfIld28+EH3K4DnBVl",
+	"032aOQtq8Axq5sUnkk9yNEzmeZstrbnVxIU7ZyVYN5aFMkytY8EcYxlxW8y5ZvIIavelOl8+75KhD0l8",
+	"bJJPGbk5a/LNqTLXotzD+oO6Y2Tj6M9amrux3MFOdczUlzP1m3tlzCrbtiBCMr49YqmphQU5kYjI1EBv",
+	"b9oygXWl5fzZsZFN6wmLrg3Q51ldY5vno1bXUXvhx6gPv6LF6nazD6ZBXWLCmPJ8R12rEzz1126gOXRe",
+	"7SH6nD78ucPpj9YvH2WAgBfd3I7tOq6PYqHWTmNWFvrGQI85geN6Mr/FhJo9TjYm025qToPO0RJnHxCh",
+	"krmwUarMjgzK0pgWIoggBZlqUxzn1J1eP8137rr5mhj2K4pd+mmeEpSJSsg3ve/VeXd9dMPxXqzGAaYN",
+	"b5j6gxOY8s0cLDkvQ6bTKzvEa0doYnWLPMTcp001iYOzWv8gq+bxKoY3ExdZTC1wEJJxcyaTxU9S6gZK",
+	"BdhySo7bdSaXdtlzLIq0Ka/qzPk0yMvXMRm8FhPWoR3xiHXYv03nmx1838zX8Eef+pN5MLgjazSY6Fta",
+	"Ie8jUyWWIOTklL0mWufv+jpC3n9O/tp7ZdmjjNWMzOER7LL4ZGou7kb5BvsR2wMexSafj0vS4UoRIVX9",
+	"2X5bJMaVM5kElC+GOppMOnwL/hySsnNfw+NMMRpgqVN4eOEKxEYZ+RWhcGZKB9ZhyVi2CoHBa6zMQAUR",
+	"YF4S42w+itF1EdtvzD7C7BNh1EEgNVV2ckyWgy68zmpTBVHlP5hkhbAQpKlM0UGhe4TRVOmNQHwx1/Ic",
+	"uwX50S1OBZ2+6PgelMx+U7mXdtWkrthCze31mqqQTVagDf4AAmF9w7x7d7Cd/E1DTVy0hydI/iHyGg/e",
+	"3n4JiZDN5uRQhd29ImNsg6yzrd0HsVs3sHDhYQLdrDqdHjfFO2XgcBctH3NisndJ8wnxyqELnx/BMZ19",
+	"3hIDsZ+wo3Jj1W8TPHTFLxefXL2BfdsR+0VQqsByhNgKCZtp5pqtL/eX7bumRNqo3Hft3GAOirHzF0H5",
+	"tXveznZv0HvsjpLOdsASM2Ali5CVJhEmWdiTj+MxZt3Gsclyi4IZ6UZUTFs1zaczRSeu7M5ofmORU0VT",
+	"p1RuP6YcTniUnQZjyZNZ5fqeGOUbmzwQm1zvZZJxmWPPlI16Ws0J7aa2mBms7Wi1Bzf0JufO1mBqznSr",
+	"bg5TZtceuuO4cUbWe7yZ/L0Lob+eTH7pq9kp3mOr4znclrSdwOFWXprPxEPwt6lw+42//5j8HeO6QQY3",
+	"T8f4uImoUiZHS6LoO1yPmZjWXbUzWcy2mJ3DWsNmUHZXgg6h3LrTvNzqAp+QnxHqREUP8Wem7Tvz+rBS",
+	"MHOfFm/dm3r0ietHV+PFTkdXWitsdeBfMShQaVNV9qXS52ZxEGqyT/TV7Iy3BjGnPXWxXX/a09Uk1qnx",
+	"ppABNFfocbZBGAkQxgWpVou74il6SXM8a/40Xprn5LXhoTmOXXe58cF8k1+mqzG81SSaoX7oMpjuZFI9",
+	"LnQK5n7LXTfzq6JX48P7H0Novd3j6iF2EinGFMtzNd5w9aNHVlzoC6ue5Sd0pLaQZo6SrZkRqUNe6lv2",
+	"AewJa1Xw2ZftloWdYFdUUupgjS6Zbtuylcn2NdKzxwSvzNif9XTajOtc4zyw9X7F1sjgul/JdeZmgcty",
+	"7/z46wo0DPGlyiiCW+AqaHxLMhiYjquy/CPNiCHJXQEcDpsc0/dI4oUtdFDFi75HZsicq8AcEPX16dXk",
+	"5ogyjswNQVFJGrmzSSSnCLlYh1/OxCrKx6k+1cYcP+qvCn6clfpSBCPmOAjJSdbU70drcgvU1fBHwe1R",
+	"ondxVGQhmpEik3BkudNITzOUPo32+oXwiJ/LISN+bhvMALP3aL2T5nG4YkJj4KBgnHlGnUfNiVkzmGRW",
+	"/hx1THbPtW2f+0z6Z/TRj/Hl+PwfqKA4FsV+q7+dnL9PMTGJqpqrnfEWpLf+XScHWP83Jun7m/1/f/Z/",
+	"J69+z05AjNmY5pocm2oWFTtGoloHxzFKatw9MYXAPa/Egxy8mVlT2EuGhidDX4esN2DwkZhiDdEJ0e2O",
+	"mQt/3/JJzqJ5fEQnuoxfhBRCpaXI7DOmhfPCbn2HZ+7FR+NV1Fll4TZ5xdzSaml7BZp53Gpu7Ml2D0Qg",
+	"oY0yhWmKONTu2iyjwkV3g0iEqJ17Uz0WeAOeQN100eAWtCP4Kfz+y2ept47uBdyXzajGA37rFGH7silc",
+	"kXN/z9U5YeqBVml2dH9jlRfxu9Q/83UugmfN+e/goQsnBY90rCX4ewjL3fvd/w8AT2nRDg61AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -2,10 +2,11 @@ package httpapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"time"
 
-	"github.com/go-chi/jwtauth/v5"
 	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 
@@ -17,14 +18,20 @@ var _ StrictServerInterface = &StrictAPIServer{}
 
 // StrictServerInterface represents all server handlers.
 type StrictAPIServer struct {
-	svc       domain.APIService
-	tokenAuth *jwtauth.JWTAuth
+	svc            domain.APIService
+	keys           *JWTKeys
+	accessTokenTTL time.Duration
 }
 
-func NewStrictAPIServer(svc domain.APIService, tokenAuth *jwtauth.JWTAuth) *StrictAPIServer {
+func NewStrictAPIServer(
+	svc domain.APIService,
+	keys *JWTKeys,
+	accessTokenTTL time.Duration,
+) *StrictAPIServer {
 	return &StrictAPIServer{
-		svc:       svc,
-		tokenAuth: tokenAuth,
+		svc:            svc,
+		keys:           keys,
+		accessTokenTTL: accessTokenTTL,
 	}
 }
 
@@ -35,23 +42,23 @@ func (s *StrictAPIServer) GetArticles(
 	request GetArticlesRequestObject,
 ) (GetArticlesResponseObject, error) {
 	// get the request context
-	articles, errA := s.svc.GetArticles(
+	page, errA := s.svc.GetArticles(
 		ctx,
 		getUserIDFromContext(ctx),
-		request.Params.Author,
-		request.Params.Tag,
-		request.Params.Favorited,
+		toDomainArticleFilter(request.Params),
 		request.Params.Limit,
 		request.Params.Offset,
+		request.Params.Cursor,
 	)
 	if errA != nil {
-		return GetArticles422JSONResponse{}, fmt.Errorf("get articles: %w", errA)
+		return nil, fmt.Errorf("get articles: %w", errA)
 	}
 
 	return GetArticles200JSONResponse{
 		MultipleArticlesResponseJSONResponse: MultipleArticlesResponseJSONResponse{
-			Articles:      fromDomainArticles(articles),
-			ArticlesCount: len(articles),
+			Articles:      fromDomainArticles(page.Articles),
+			ArticlesCount: page.Total,
+			NextCursor:    fromDomainNextCursor(page.NextCursor),
 		},
 	}, nil
 }
@@ -69,9 +76,11 @@ func (s *StrictAPIServer) CreateArticle(
 		request.Body.Article.Description,
 		request.Body.Article.Body,
 		*request.Body.Article.TagList,
+		request.Body.Article.Draft != nil && *request.Body.Article.Draft,
+		request.Body.Article.PublishAt,
 	)
 	if err != nil {
-		return CreateArticle422JSONResponse{}, fmt.Errorf("create article: %w", err)
+		return nil, fmt.Errorf("create article: %w", err)
 	}
 
 	return CreateArticle201JSONResponse{
@@ -87,23 +96,96 @@ func (s *StrictAPIServer) GetArticlesFeed(
 	ctx context.Context,
 	request GetArticlesFeedRequestObject,
 ) (GetArticlesFeedResponseObject, error) {
-	articles, errA := s.svc.GetFeedArticles(
+	page, errA := s.svc.GetFeedArticles(
 		ctx,
 		getUserIDFromContext(ctx),
-		nil,
-		nil,
-		nil,
+		domain.ArticleFilter{},
 		request.Params.Limit,
 		request.Params.Offset,
+		request.Params.Cursor,
 	)
 	if errA != nil {
-		return GetArticlesFeed422JSONResponse{}, fmt.Errorf("get articles feed: %w", errA)
+		return nil, fmt.Errorf("get articles feed: %w", errA)
 	}
 
 	return GetArticlesFeed200JSONResponse{
 		MultipleArticlesResponseJSONResponse: MultipleArticlesResponseJSONResponse{
-			Articles:      fromDomainArticles(articles),
-			ArticlesCount: len(articles),
+			Articles:      fromDomainArticles(page.Articles),
+			ArticlesCount: page.Total,
+			NextCursor:    fromDomainNextCursor(page.NextCursor),
+		},
+	}, nil
+}
+
+// Get the trashed articles
+// (GET /user/trash)
+func (s *StrictAPIServer) GetTrashedArticles(
+	ctx context.Context,
+	request GetTrashedArticlesRequestObject,
+) (GetTrashedArticlesResponseObject, error) {
+	page, err := s.svc.GetTrashedArticles(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Params.Limit,
+		request.Params.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get trashed articles: %w", err)
+	}
+
+	return GetTrashedArticles200JSONResponse{
+		MultipleArticlesResponseJSONResponse: MultipleArticlesResponseJSONResponse{
+			Articles:      fromDomainArticles(page.Articles),
+			ArticlesCount: page.Total,
+		},
+	}, nil
+}
+
+// Get the drafts
+// (GET /user/drafts)
+func (s *StrictAPIServer) GetDraftArticles(
+	ctx context.Context,
+	request GetDraftArticlesRequestObject,
+) (GetDraftArticlesResponseObject, error) {
+	page, err := s.svc.GetDraftArticles(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Params.Limit,
+		request.Params.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get draft articles: %w", err)
+	}
+
+	return GetDraftArticles200JSONResponse{
+		MultipleArticlesResponseJSONResponse: MultipleArticlesResponseJSONResponse{
+			Articles:      fromDomainArticles(page.Articles),
+			ArticlesCount: page.Total,
+		},
+	}, nil
+}
+
+// Search articles
+// (GET /articles/search)
+func (s *StrictAPIServer) SearchArticles(
+	ctx context.Context,
+	request SearchArticlesRequestObject,
+) (SearchArticlesResponseObject, error) {
+	page, err := s.svc.SearchArticles(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Params.Q,
+		request.Params.Limit,
+		request.Params.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("search articles: %w", err)
+	}
+
+	return SearchArticles200JSONResponse{
+		ArticleSearchResponseJSONResponse: ArticleSearchResponseJSONResponse{
+			Articles:      fromDomainArticleSearchResults(page.Results),
+			ArticlesCount: page.Total,
 		},
 	}, nil
 }
@@ -115,7 +197,7 @@ func (s *StrictAPIServer) DeleteArticle(
 	request DeleteArticleRequestObject,
 ) (DeleteArticleResponseObject, error) {
 	if err := s.svc.DeleteArticle(ctx, getUserIDFromContext(ctx), request.Slug); err != nil {
-		return DeleteArticle422JSONResponse{}, fmt.Errorf("delete article: %w", err)
+		return nil, fmt.Errorf("delete article: %w", err)
 	}
 
 	return DeleteArticle200Response{}, nil
@@ -129,13 +211,33 @@ func (s *StrictAPIServer) GetArticle(
 ) (GetArticleResponseObject, error) {
 	article, errA := s.svc.GetArticle(ctx, getUserIDFromContext(ctx), request.Slug)
 	if errA != nil {
-		return GetArticle422JSONResponse{}, fmt.Errorf("get article: %w", errA)
+		var moved *domain.ArticleMovedError
+		if errors.As(errA, &moved) {
+			return GetArticle301Response{
+				Headers: GetArticle301ResponseHeaders{
+					Location: "/articles/" + url.PathEscape(moved.Slug),
+				},
+			}, nil
+		}
+
+		return nil, fmt.Errorf("get article: %w", errA)
+	}
+
+	resp, errR := versionedArticleResponse(article)
+	if errR != nil {
+		return nil, fmt.Errorf("get article: %w", errR)
+	}
+
+	if ifNoneMatch(request.Params.IfNoneMatch, resp.Headers.ETag) {
+		return GetArticle304Response{
+			Headers: NotModifiedResponseHeaders{
+				ETag: resp.Headers.ETag,
+			},
+		}, nil
 	}
 
 	return GetArticle200JSONResponse{
-		SingleArticleResponseJSONResponse: SingleArticleResponseJSONResponse{
-			Article: fromDomainArticle(article),
-		},
+		VersionedArticleResponseJSONResponse: resp,
 	}, nil
 }
 
@@ -152,15 +254,20 @@ func (s *StrictAPIServer) UpdateArticle(
 		request.Body.Article.Title,
 		request.Body.Article.Description,
 		request.Body.Article.Body,
+		request.Body.Article.TagList,
+		ifMatchVersions(request.Params.IfMatch),
 	)
 	if err != nil {
-		return UpdateArticle422JSONResponse{}, fmt.Errorf("update article: %w", err)
+		return nil, fmt.Errorf("update article: %w", err)
+	}
+
+	resp, errR := versionedArticleResponse(art)
+	if errR != nil {
+		return nil, fmt.Errorf("update article: %w", errR)
 	}
 
 	return UpdateArticle200JSONResponse{
-		SingleArticleResponseJSONResponse: SingleArticleResponseJSONResponse{
-			Article: fromDomainArticle(art),
-		},
+		VersionedArticleResponseJSONResponse: resp,
 	}, nil
 }
 
@@ -170,14 +277,23 @@ func (s *StrictAPIServer) GetArticleComments(
 	ctx context.Context,
 	request GetArticleCommentsRequestObject,
 ) (GetArticleCommentsResponseObject, error) {
-	comments, err := s.svc.GetComments(ctx, getUserIDFromContext(ctx), request.Slug)
+	page, err := s.svc.GetComments(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Slug,
+		toDomainCommentSort(request.Params.Sort),
+		request.Params.Limit,
+		request.Params.Offset,
+		request.Params.Cursor,
+	)
 	if err != nil {
-		return GetArticleComments422JSONResponse{}, fmt.Errorf("get article comment: %w", err)
+		return nil, fmt.Errorf("get article comment: %w", err)
 	}
 
 	return GetArticleComments200JSONResponse{
 		MultipleCommentsResponseJSONResponse: MultipleCommentsResponseJSONResponse{
-			Comments: fromDomainComments(comments),
+			Comments:   fromDomainComments(page.Comments),
+			NextCursor: fromDomainNextCursor(page.NextCursor),
 		},
 	}, nil
 }
@@ -193,9 +309,10 @@ func (s *StrictAPIServer) CreateArticleComment(
 		getUserIDFromContext(ctx),
 		request.Slug,
 		request.Body.Comment.Body,
+		request.Body.Comment.ParentId,
 	)
 	if err != nil {
-		return CreateArticleComment422JSONResponse{}, fmt.Errorf("create article comment: %w", err)
+		return nil, fmt.Errorf("create article comment: %w", err)
 	}
 
 	return CreateArticleComment200JSONResponse{
@@ -205,14 +322,43 @@ func (s *StrictAPIServer) CreateArticleComment(
 	}, nil
 }
 
+// Update a comment for an article
+// (PUT /articles/{slug}/comments/{id})
+func (s *StrictAPIServer) UpdateArticleComment(
+	ctx context.Context,
+	request UpdateArticleCommentRequestObject,
+) (UpdateArticleCommentResponseObject, error) {
+	comment, err := s.svc.UpdateComment(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Slug,
+		request.Id,
+		request.Body.Comment.Body,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("update article comment: %w", err)
+	}
+
+	return UpdateArticleComment200JSONResponse{
+		SingleCommentResponseJSONResponse: SingleCommentResponseJSONResponse{
+			Comment: fromDomainComment(comment),
+		},
+	}, nil
+}
+
 // Delete a comment for an article
 // (DELETE /articles/{slug}/comments/{id})
 func (s *StrictAPIServer) DeleteArticleComment(
 	ctx context.Context,
 	request DeleteArticleCommentRequestObject,
 ) (DeleteArticleCommentResponseObject, error) {
-	if err := s.svc.DeleteComment(ctx, request.Slug, request.Id); err != nil {
-		return DeleteArticleComment422JSONResponse{}, fmt.Errorf("delete article comment: %w", err)
+	if err := s.svc.DeleteComment(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Slug,
+		request.Id,
+	); err != nil {
+		return nil, fmt.Errorf("delete article comment: %w", err)
 	}
 
 	return DeleteArticleComment200Response{}, nil
@@ -226,7 +372,7 @@ func (s *StrictAPIServer) DeleteArticleFavorite(
 ) (DeleteArticleFavoriteResponseObject, error) {
 	art, err := s.svc.UnfavoriteArticle(ctx, getUserIDFromContext(ctx), request.Slug)
 	if err != nil {
-		return DeleteArticleFavorite422JSONResponse{}, fmt.Errorf(
+		return nil, fmt.Errorf(
 			"delete article favorite: %w",
 			err,
 		)
@@ -239,6 +385,151 @@ func (s *StrictAPIServer) DeleteArticleFavorite(
 	}, nil
 }
 
+// Publish an article
+// (POST /articles/{slug}/publish)
+func (s *StrictAPIServer) PublishArticle(
+	ctx context.Context,
+	request PublishArticleRequestObject,
+) (PublishArticleResponseObject, error) {
+	art, err := s.svc.PublishArticle(ctx, getUserIDFromContext(ctx), request.Slug, request.Params.PublishAt)
+	if err != nil {
+		return nil, fmt.Errorf("publish article: %w", err)
+	}
+
+	return PublishArticle200JSONResponse{
+		SingleArticleResponseJSONResponse: SingleArticleResponseJSONResponse{
+			Article: fromDomainArticle(art),
+		},
+	}, nil
+}
+
+// Unpublish an article
+// (DELETE /articles/{slug}/publish)
+func (s *StrictAPIServer) UnpublishArticle(
+	ctx context.Context,
+	request UnpublishArticleRequestObject,
+) (UnpublishArticleResponseObject, error) {
+	art, err := s.svc.UnpublishArticle(ctx, getUserIDFromContext(ctx), request.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("unpublish article: %w", err)
+	}
+
+	return UnpublishArticle200JSONResponse{
+		SingleArticleResponseJSONResponse: SingleArticleResponseJSONResponse{
+			Article: fromDomainArticle(art),
+		},
+	}, nil
+}
+
+// Restore a trashed article
+// (POST /articles/{slug}/restore)
+func (s *StrictAPIServer) RestoreArticle(
+	ctx context.Context,
+	request RestoreArticleRequestObject,
+) (RestoreArticleResponseObject, error) {
+	art, err := s.svc.RestoreArticle(ctx, getUserIDFromContext(ctx), request.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("restore article: %w", err)
+	}
+
+	return RestoreArticle200JSONResponse{
+		SingleArticleResponseJSONResponse: SingleArticleResponseJSONResponse{
+			Article: fromDomainArticle(art),
+		},
+	}, nil
+}
+
+// Get the revisions of an article
+// (GET /articles/{slug}/revisions)
+func (s *StrictAPIServer) GetArticleRevisions(
+	ctx context.Context,
+	request GetArticleRevisionsRequestObject,
+) (GetArticleRevisionsResponseObject, error) {
+	revisions, err := s.svc.GetArticleRevisions(ctx, getUserIDFromContext(ctx), request.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("get article revisions: %w", err)
+	}
+
+	return GetArticleRevisions200JSONResponse{
+		MultipleArticleRevisionsResponseJSONResponse: MultipleArticleRevisionsResponseJSONResponse{
+			Revisions: fromDomainArticleRevisions(revisions),
+		},
+	}, nil
+}
+
+// Get a revision of an article
+// (GET /articles/{slug}/revisions/{number})
+func (s *StrictAPIServer) GetArticleRevision(
+	ctx context.Context,
+	request GetArticleRevisionRequestObject,
+) (GetArticleRevisionResponseObject, error) {
+	revision, err := s.svc.GetArticleRevision(ctx, getUserIDFromContext(ctx), request.Slug, request.Number)
+	if err != nil {
+		return nil, fmt.Errorf("get article revision: %w", err)
+	}
+
+	return GetArticleRevision200JSONResponse{
+		SingleArticleRevisionResponseJSONResponse: SingleArticleRevisionResponseJSONResponse{
+			Revision: fromDomainArticleRevision(revision),
+		},
+	}, nil
+}
+
+// Diff two revisions of an article
+// (GET /articles/{slug}/revisions/{number}/diff)
+func (s *StrictAPIServer) GetArticleRevisionDiff(
+	ctx context.Context,
+	request GetArticleRevisionDiffRequestObject,
+) (GetArticleRevisionDiffResponseObject, error) {
+	diff, err := s.svc.DiffArticleRevisions(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Slug,
+		request.Params.From,
+		request.Number,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get article revision diff: %w", err)
+	}
+
+	return GetArticleRevisionDiff200JSONResponse{
+		ArticleRevisionDiffResponseJSONResponse: ArticleRevisionDiffResponseJSONResponse{
+			Diff: ArticleRevisionDiff{
+				From: diff.From,
+				To:   diff.To,
+				Diff: diff.Diff,
+			},
+		},
+	}, nil
+}
+
+// Restore a revision of an article
+// (POST /articles/{slug}/revisions/{number}/restore)
+func (s *StrictAPIServer) RestoreArticleRevision(
+	ctx context.Context,
+	request RestoreArticleRevisionRequestObject,
+) (RestoreArticleRevisionResponseObject, error) {
+	art, err := s.svc.RestoreArticleRevision(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Slug,
+		request.Number,
+		ifMatchVersions(request.Params.IfMatch),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("restore article revision: %w", err)
+	}
+
+	resp, errR := versionedArticleResponse(art)
+	if errR != nil {
+		return nil, fmt.Errorf("restore article revision: %w", errR)
+	}
+
+	return RestoreArticleRevision200JSONResponse{
+		VersionedArticleResponseJSONResponse: resp,
+	}, nil
+}
+
 // Favorite an article
 // (POST /articles/{slug}/favorite)
 func (s *StrictAPIServer) CreateArticleFavorite(
@@ -247,7 +538,7 @@ func (s *StrictAPIServer) CreateArticleFavorite(
 ) (CreateArticleFavoriteResponseObject, error) {
 	art, err := s.svc.FavoriteArticle(ctx, getUserIDFromContext(ctx), request.Slug)
 	if err != nil {
-		return CreateArticleFavorite422JSONResponse{}, fmt.Errorf(
+		return nil, fmt.Errorf(
 			"create article favorite: %w",
 			err,
 		)
@@ -268,7 +559,7 @@ func (s *StrictAPIServer) GetProfileByUsername(
 ) (GetProfileByUsernameResponseObject, error) {
 	profile, err := s.svc.GetProfile(ctx, getUserIDFromContext(ctx), request.Username)
 	if err != nil {
-		return GetProfileByUsername422JSONResponse{}, fmt.Errorf("get profile by username: %w", err)
+		return nil, fmt.Errorf("get profile by username: %w", err)
 	}
 
 	return GetProfileByUsername200JSONResponse{
@@ -286,7 +577,7 @@ func (s *StrictAPIServer) UnfollowUserByUsername(
 ) (UnfollowUserByUsernameResponseObject, error) {
 	prof, err := s.svc.UnfollowUser(ctx, getUserIDFromContext(ctx), request.Username)
 	if err != nil {
-		return UnfollowUserByUsername422JSONResponse{}, fmt.Errorf("unfollow by username: %w", err)
+		return nil, fmt.Errorf("unfollow by username: %w", err)
 	}
 
 	return UnfollowUserByUsername200JSONResponse{
@@ -304,7 +595,7 @@ func (s *StrictAPIServer) FollowUserByUsername(
 ) (FollowUserByUsernameResponseObject, error) {
 	prof, err := s.svc.FollowUser(ctx, getUserIDFromContext(ctx), request.Username)
 	if err != nil {
-		return FollowUserByUsername422JSONResponse{}, fmt.Errorf("follow by username: %w", err)
+		return nil, fmt.Errorf("follow by username: %w", err)
 	}
 
 	return FollowUserByUsername200JSONResponse{
@@ -314,6 +605,74 @@ func (s *StrictAPIServer) FollowUserByUsername(
 	}, nil
 }
 
+// Get the followers of a user
+// (GET /profiles/{username}/followers)
+func (s *StrictAPIServer) GetProfileFollowers(
+	ctx context.Context,
+	request GetProfileFollowersRequestObject,
+) (GetProfileFollowersResponseObject, error) {
+	page, err := s.svc.GetFollowers(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Username,
+		request.Params.Limit,
+		request.Params.Cursor,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get profile followers: %w", err)
+	}
+
+	return GetProfileFollowers200JSONResponse{
+		MultipleProfilesResponseJSONResponse: MultipleProfilesResponseJSONResponse{
+			Profiles:   fromDomainFollows(page.Follows),
+			NextCursor: fromDomainNextCursor(page.NextCursor),
+		},
+	}, nil
+}
+
+// Get the users a user follows
+// (GET /profiles/{username}/following)
+func (s *StrictAPIServer) GetProfileFollowing(
+	ctx context.Context,
+	request GetProfileFollowingRequestObject,
+) (GetProfileFollowingResponseObject, error) {
+	page, err := s.svc.GetFollowing(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Username,
+		request.Params.Limit,
+		request.Params.Cursor,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get profile following: %w", err)
+	}
+
+	return GetProfileFollowing200JSONResponse{
+		MultipleProfilesResponseJSONResponse: MultipleProfilesResponseJSONResponse{
+			Profiles:   fromDomainFollows(page.Follows),
+			NextCursor: fromDomainNextCursor(page.NextCursor),
+		},
+	}, nil
+}
+
+// Preview markdown
+// (POST /markdown/preview)
+func (s *StrictAPIServer) PreviewMarkdown(
+	ctx context.Context,
+	request PreviewMarkdownRequestObject,
+) (PreviewMarkdownResponseObject, error) {
+	html, err := s.svc.RenderMarkdown(ctx, request.Body.Body)
+	if err != nil {
+		return nil, fmt.Errorf("preview markdown: %w", err)
+	}
+
+	return PreviewMarkdown200JSONResponse{
+		MarkdownPreviewResponseJSONResponse: MarkdownPreviewResponseJSONResponse{
+			BodyHtml: html,
+		},
+	}, nil
+}
+
 // Get tags
 // (GET /tags)
 func (s *StrictAPIServer) GetTags(
@@ -322,7 +681,7 @@ func (s *StrictAPIServer) GetTags(
 ) (GetTagsResponseObject, error) {
 	tags, err := s.svc.GetTags(ctx)
 	if err != nil {
-		return GetTags422JSONResponse{}, fmt.Errorf("get tags: %w", err)
+		return nil, fmt.Errorf("get tags: %w", err)
 	}
 
 	return GetTags200JSONResponse{
@@ -336,17 +695,28 @@ func (s *StrictAPIServer) GetTags(
 // (GET /user)
 func (s *StrictAPIServer) GetCurrentUser(
 	ctx context.Context,
-	_ GetCurrentUserRequestObject,
+	request GetCurrentUserRequestObject,
 ) (GetCurrentUserResponseObject, error) {
 	user, err := s.svc.GetCurrentUser(ctx, getUserIDFromContext(ctx))
 	if err != nil {
-		return GetCurrentUser422JSONResponse{}, fmt.Errorf("get current user: %w", err)
+		return nil, fmt.Errorf("get current user: %w", err)
+	}
+
+	resp, errR := versionedUserResponse(fromDomainUser(user, getTokenFromContext(ctx)), user.Version)
+	if errR != nil {
+		return nil, fmt.Errorf("get current user: %w", errR)
+	}
+
+	if ifNoneMatch(request.Params.IfNoneMatch, resp.Headers.ETag) {
+		return GetCurrentUser304Response{
+			Headers: NotModifiedResponseHeaders{
+				ETag: resp.Headers.ETag,
+			},
+		}, nil
 	}
 
 	return GetCurrentUser200JSONResponse{
-		UserResponseJSONResponse: UserResponseJSONResponse{
-			User: fromDomainUser(user, getTokenFromContext(ctx)),
-		},
+		VersionedUserResponseJSONResponse: resp,
 	}, nil
 }
 
@@ -356,6 +726,12 @@ func (s *StrictAPIServer) UpdateCurrentUser(
 	ctx context.Context,
 	request UpdateCurrentUserRequestObject,
 ) (UpdateCurrentUserResponseObject, error) {
+	if request.Body.User.Password != nil || request.Body.User.Email != nil {
+		if jwtToken := getJWTFromContext(ctx); jwtToken == nil || getSessionIDFromToken(jwtToken) == uuid.Nil {
+			return nil, fmt.Errorf("update current user: %w", ErrSessionRequired)
+		}
+	}
+
 	usr, err := s.svc.UpdateUser(
 		ctx,
 		getUserIDFromContext(ctx),
@@ -364,15 +740,31 @@ func (s *StrictAPIServer) UpdateCurrentUser(
 		request.Body.User.Password,
 		request.Body.User.Bio,
 		request.Body.User.Image,
+		ifMatchVersions(request.Params.IfMatch),
 	)
 	if err != nil {
-		return UpdateCurrentUser422JSONResponse{}, fmt.Errorf("update current user: %w", err)
+		return nil, fmt.Errorf("update current user: %w", err)
+	}
+
+	user := fromDomainUser(usr, getTokenFromContext(ctx))
+
+	// changing the password revoked all the sessions, including this one
+	if request.Body.User.Password != nil {
+		var errS error
+
+		user, errS = s.newSession(ctx, usr)
+		if errS != nil {
+			return nil, fmt.Errorf("update current user: %w", errS)
+		}
+	}
+
+	resp, errR := versionedUserResponse(user, usr.Version)
+	if errR != nil {
+		return nil, fmt.Errorf("update current user: %w", errR)
 	}
 
 	return UpdateCurrentUser200JSONResponse{
-		UserResponseJSONResponse: UserResponseJSONResponse{
-			User: fromDomainUser(usr, getTokenFromContext(ctx)),
-		},
+		VersionedUserResponseJSONResponse: resp,
 	}, nil
 }
 
@@ -389,7 +781,7 @@ func (s *StrictAPIServer) CreateUser(
 		request.Body.User.Password,
 	)
 	if err != nil {
-		return CreateUser422JSONResponse{}, fmt.Errorf("create user: %w", err)
+		return nil, fmt.Errorf("create user: %w", err)
 	}
 
 	return CreateUser201JSONResponse{
@@ -405,24 +797,254 @@ func (s *StrictAPIServer) Login(
 	ctx context.Context,
 	request LoginRequestObject,
 ) (LoginResponseObject, error) {
-	usr, _, err := s.svc.AuthUser(ctx, request.Body.User.Email, request.Body.User.Password)
+	usr, err := s.svc.AuthUser(ctx, request.Body.User.Email, request.Body.User.Password)
 	if err != nil {
-		return Login401Response{}, fmt.Errorf("login: %w", err)
+		return nil, fmt.Errorf("login: %w", err)
 	}
 
-	// set a jwt
-	_, jws, errE := s.tokenAuth.Encode(map[string]any{
-		jwt.SubjectKey:    usr.ID.String(),
-		jwt.IssuedAtKey:   time.Now().Unix(),
-		jwt.ExpirationKey: time.Now().Add(1 * time.Hour).Unix(),
-	})
-	if errE != nil {
-		return Login422JSONResponse{}, fmt.Errorf("login encode token: %w", errE)
+	user, errS := s.newSession(ctx, usr)
+	if errS != nil {
+		return nil, fmt.Errorf("login: %w", errS)
 	}
 
 	return &Login200JSONResponse{
 		UserResponseJSONResponse: UserResponseJSONResponse{
-			User: fromDomainUser(usr, jws),
+			User: user,
 		},
 	}, nil
 }
+
+// Refresh the access token
+// (POST /users/token/refresh)
+func (s *StrictAPIServer) RefreshToken(
+	ctx context.Context,
+	request RefreshTokenRequestObject,
+) (RefreshTokenResponseObject, error) {
+	usr, refreshToken, err := s.svc.RotateRefreshToken(ctx, request.Body.User.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("refresh token: %w", err)
+	}
+
+	jws, errE := s.encodeAccessToken(usr.ID, refreshToken.FamilyID)
+	if errE != nil {
+		return nil, fmt.Errorf("refresh token encode token: %w", errE)
+	}
+
+	user := fromDomainUser(usr, jws)
+	user.RefreshToken = &refreshToken.Token
+
+	return RefreshToken200JSONResponse{
+		UserResponseJSONResponse: UserResponseJSONResponse{
+			User: user,
+		},
+	}, nil
+}
+
+// Log out
+// (POST /user/logout)
+func (s *StrictAPIServer) Logout(
+	ctx context.Context,
+	_ LogoutRequestObject,
+) (LogoutResponseObject, error) {
+	token := getJWTFromContext(ctx)
+	if token == nil {
+		return nil, fmt.Errorf("logout: %w", ErrMissingTokenID)
+	}
+
+	tokenID, errT := uuid.Parse(token.JwtID())
+	if errT != nil {
+		return nil, fmt.Errorf("logout: %w: %w", ErrMissingTokenID, errT)
+	}
+
+	if err := s.svc.RevokeSession(
+		ctx,
+		getUserIDFromContext(ctx),
+		tokenID,
+		token.Expiration(),
+		getSessionIDFromToken(token),
+	); err != nil {
+		return nil, fmt.Errorf("logout: %w", err)
+	}
+
+	return Logout200Response{}, nil
+}
+
+// Log out everywhere
+// (POST /user/logout/all)
+func (s *StrictAPIServer) LogoutAll(
+	ctx context.Context,
+	_ LogoutAllRequestObject,
+) (LogoutAllResponseObject, error) {
+	if err := s.svc.RevokeAllSessions(ctx, getUserIDFromContext(ctx)); err != nil {
+		return nil, fmt.Errorf("logout all: %w", err)
+	}
+
+	return LogoutAll200Response{}, nil
+}
+
+// List personal access tokens
+// (GET /user/tokens)
+func (s *StrictAPIServer) GetPersonalAccessTokens(
+	ctx context.Context,
+	_ GetPersonalAccessTokensRequestObject,
+) (GetPersonalAccessTokensResponseObject, error) {
+	tokens, err := s.svc.GetPersonalAccessTokens(ctx, getUserIDFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("get personal access tokens: %w", err)
+	}
+
+	return GetPersonalAccessTokens200JSONResponse{
+		MultiplePersonalAccessTokensResponseJSONResponse: MultiplePersonalAccessTokensResponseJSONResponse{
+			Tokens: fromDomainPersonalAccessTokens(tokens),
+		},
+	}, nil
+}
+
+// Create a personal access token
+// (POST /user/tokens)
+func (s *StrictAPIServer) CreatePersonalAccessToken(
+	ctx context.Context,
+	request CreatePersonalAccessTokenRequestObject,
+) (CreatePersonalAccessTokenResponseObject, error) {
+	scopes := make([]string, 0, len(request.Body.Token.Scopes))
+	for _, scope := range request.Body.Token.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	pat, errN := domain.NewPersonalAccessToken(
+		getUserIDFromContext(ctx),
+		request.Body.Token.Name,
+		scopes,
+		request.Body.Token.ExpiresAt,
+	)
+	if errN != nil {
+		return nil, fmt.Errorf("create personal access token: %w", errN)
+	}
+
+	jws, errE := s.encodePersonalAccessToken(pat)
+	if errE != nil {
+		return nil, fmt.Errorf("create personal access token encode token: %w", errE)
+	}
+
+	pat.TokenHash = domain.HashToken(jws)
+
+	if err := s.svc.CreatePersonalAccessToken(ctx, pat); err != nil {
+		return nil, fmt.Errorf("create personal access token: %w", err)
+	}
+
+	token := fromDomainPersonalAccessToken(pat)
+	token.Token = &jws
+
+	return CreatePersonalAccessToken201JSONResponse{
+		SinglePersonalAccessTokenResponseJSONResponse: SinglePersonalAccessTokenResponseJSONResponse{
+			Token: token,
+		},
+	}, nil
+}
+
+// Revoke a personal access token
+// (DELETE /user/tokens/{id})
+func (s *StrictAPIServer) DeletePersonalAccessToken(
+	ctx context.Context,
+	request DeletePersonalAccessTokenRequestObject,
+) (DeletePersonalAccessTokenResponseObject, error) {
+	if err := s.svc.RevokePersonalAccessToken(
+		ctx,
+		getUserIDFromContext(ctx),
+		request.Id,
+	); err != nil {
+		return nil, fmt.Errorf("delete personal access token: %w", err)
+	}
+
+	return DeletePersonalAccessToken200Response{}, nil
+}
+
+// newSession issues a refresh token and an access token of the same session.
+func (s *StrictAPIServer) newSession(ctx context.Context, usr *domain.User) (User, error) {
+	refreshToken, errR := s.svc.CreateRefreshToken(ctx, usr.ID)
+	if errR != nil {
+		return User{}, fmt.Errorf("create refresh token: %w", errR)
+	}
+
+	jws, errE := s.encodeAccessToken(usr.ID, refreshToken.FamilyID)
+	if errE != nil {
+		return User{}, fmt.Errorf("encode token: %w", errE)
+	}
+
+	user := fromDomainUser(usr, jws)
+	user.RefreshToken = &refreshToken.Token
+
+	return user, nil
+}
+
+// encodeAccessToken signs a short-lived jwt for the user, identified by its jti
+// so that it can be revoked.
+func (s *StrictAPIServer) encodeAccessToken(userID, sessionID uuid.UUID) (string, error) {
+	tokenID, errU := uuid.NewV7()
+	if errU != nil {
+		return "", fmt.Errorf("could not generate token id: %w", errU)
+	}
+
+	now := time.Now()
+
+	claims := map[string]any{
+		jwt.JwtIDKey:      tokenID.String(),
+		jwt.SubjectKey:    userID.String(),
+		jwt.IssuedAtKey:   now.Unix(),
+		jwt.ExpirationKey: now.Add(s.accessTokenTTL).Unix(),
+		SessionIDClaim:    sessionID.String(),
+	}
+
+	// a session holds every scope
+	for _, scope := range domain.SessionScopes() {
+		claims[scope] = true
+	}
+
+	jws, err := s.keys.Encode(claims)
+	if err != nil {
+		return "", fmt.Errorf("could not encode token: %w", err)
+	}
+
+	return jws, nil
+}
+
+// encodePersonalAccessToken signs a long-lived jwt holding only the scopes of the token,
+// and no session.
+func (s *StrictAPIServer) encodePersonalAccessToken(pat *domain.PersonalAccessToken) (string, error) {
+	claims := map[string]any{
+		jwt.JwtIDKey:      pat.ID.String(),
+		jwt.SubjectKey:    pat.UserID.String(),
+		jwt.IssuedAtKey:   time.Now().Unix(),
+		jwt.ExpirationKey: pat.ExpiresAt.Unix(),
+	}
+
+	for _, scope := range pat.Scopes {
+		claims[scope] = true
+	}
+
+	jws, err := s.keys.Encode(claims)
+	if err != nil {
+		return "", fmt.Errorf("could not encode token: %w", err)
+	}
+
+	return jws, nil
+}
+
+func getSessionIDFromToken(token jwt.Token) uuid.UUID {
+	sessionIDAny, ok := token.Get(SessionIDClaim)
+	if !ok {
+		return uuid.Nil
+	}
+
+	sids, ok := sessionIDAny.(string)
+	if !ok {
+		return uuid.Nil
+	}
+
+	sessionID, err := uuid.Parse(sids)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	return sessionID
+}
@@ -5,32 +5,140 @@ package httpapi
 
 import (
 	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	TokenScopes = "Token.Scopes"
 )
 
+// Defines values for ArticleStatus.
+const (
+	Draft     ArticleStatus = "draft"
+	Published ArticleStatus = "published"
+	Scheduled ArticleStatus = "scheduled"
+)
+
+// Defines values for NewPersonalAccessTokenScopes.
+const (
+	ArticlesWrite NewPersonalAccessTokenScopes = "articles:write"
+	CommentsWrite NewPersonalAccessTokenScopes = "comments:write"
+	ProfilesWrite NewPersonalAccessTokenScopes = "profiles:write"
+	UserWrite     NewPersonalAccessTokenScopes = "user:write"
+)
+
+// Defines values for GetArticlesParamsTagMatch.
+const (
+	All GetArticlesParamsTagMatch = "all"
+	Any GetArticlesParamsTagMatch = "any"
+)
+
+// Defines values for GetArticlesParamsSort.
+const (
+	GetArticlesParamsSortMostCommented GetArticlesParamsSort = "most_commented"
+	GetArticlesParamsSortMostFavorited GetArticlesParamsSort = "most_favorited"
+	GetArticlesParamsSortNewest        GetArticlesParamsSort = "newest"
+	GetArticlesParamsSortOldest        GetArticlesParamsSort = "oldest"
+)
+
+// Defines values for GetArticleCommentsParamsSort.
+const (
+	GetArticleCommentsParamsSortNewest GetArticleCommentsParamsSort = "newest"
+	GetArticleCommentsParamsSortOldest GetArticleCommentsParamsSort = "oldest"
+	GetArticleCommentsParamsSortTop    GetArticleCommentsParamsSort = "top"
+)
+
 // Article defines model for Article.
 type Article struct {
+	Author Profile `json:"author"`
+	Body   string  `json:"body"`
+
+	// BodyHtml The markdown body rendered to sanitized html, like /markdown/preview does
+	BodyHtml *string `json:"bodyHtml,omitempty"`
+
+	// CommentsCount Number of comments and replies, deleted ones excluded
+	CommentsCount  int       `json:"commentsCount"`
+	CreatedAt      time.Time `json:"createdAt"`
+	Description    string    `json:"description"`
+	Favorited      bool      `json:"favorited"`
+	FavoritesCount int       `json:"favoritesCount"`
+
+	// PublishAt Scheduled publication time of the article
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+
+	// ReadingTime Estimated reading time, in minutes
+	ReadingTime int    `json:"readingTime"`
+	Slug        string `json:"slug"`
+
+	// Status Only the published articles are listed, the others are only visible to their author
+	Status          ArticleStatus          `json:"status"`
+	TableOfContents []TableOfContentsEntry `json:"tableOfContents"`
+	TagList         []string               `json:"tagList"`
+	Title           string                 `json:"title"`
+	UpdatedAt       time.Time              `json:"updatedAt"`
+	WordCount       int                    `json:"wordCount"`
+}
+
+// ArticleRevision defines model for ArticleRevision.
+type ArticleRevision struct {
+	Body        string    `json:"body"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Description string    `json:"description"`
+	Editor      *Profile  `json:"editor,omitempty"`
+	Number      int       `json:"number"`
+	Title       string    `json:"title"`
+}
+
+// ArticleRevisionDiff defines model for ArticleRevisionDiff.
+type ArticleRevisionDiff struct {
+	// Diff Unified diff with a file per changed field, empty for the same content
+	Diff string `json:"diff"`
+	From int    `json:"from"`
+	To   int    `json:"to"`
+}
+
+// ArticleSearchResult defines model for ArticleSearchResult.
+type ArticleSearchResult struct {
 	Author         Profile   `json:"author"`
-	Body           string    `json:"body"`
 	CreatedAt      time.Time `json:"createdAt"`
 	Description    string    `json:"description"`
 	Favorited      bool      `json:"favorited"`
 	FavoritesCount int       `json:"favoritesCount"`
+	Rank           float64   `json:"rank"`
 	Slug           string    `json:"slug"`
-	TagList        []string  `json:"tagList"`
-	Title          string    `json:"title"`
+
+	// Snippet html escaped fragments of the body, the matches wrapped in <mark>
+	Snippet string   `json:"snippet"`
+	TagList []string `json:"tagList"`
+	Title   string   `json:"title"`
+
+	// TitleHighlight html escaped title, the matches wrapped in <mark>
+	TitleHighlight string    `json:"titleHighlight"`
 	UpdatedAt      time.Time `json:"updatedAt"`
 }
 
+// ArticleStatus Only the published articles are listed, the others are only visible to their author
+type ArticleStatus string
+
 // Comment defines model for Comment.
 type Comment struct {
-	Author    Profile   `json:"author"`
+	Author    *Profile  `json:"author,omitempty"`
 	Body      string    `json:"body"`
 	CreatedAt time.Time `json:"createdAt"`
-	Id        int       `json:"id"`
+
+	// Deleted A deleted comment with replies keeps its place in the thread, with a placeholder body and no author
+	Deleted bool `json:"deleted"`
+
+	// Depth Nesting level of the comment, 0 for the comments of the article
+	Depth int `json:"depth"`
+
+	// Edited Whether the body was updated since the comment was posted, at updatedAt
+	Edited bool `json:"edited"`
+	Id     int  `json:"id"`
+
+	// ParentId Id of the comment this one replies to
+	ParentId  *int      `json:"parentId,omitempty"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
@@ -41,6 +149,12 @@ type GenericErrorModel struct {
 	} `json:"errors"`
 }
 
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
 // LoginUser defines model for LoginUser.
 type LoginUser struct {
 	Email    string `json:"email"`
@@ -49,17 +163,37 @@ type LoginUser struct {
 
 // NewArticle defines model for NewArticle.
 type NewArticle struct {
-	Body        string    `json:"body"`
-	Description string    `json:"description"`
-	TagList     *[]string `json:"tagList,omitempty"`
-	Title       string    `json:"title"`
+	Body        string `json:"body"`
+	Description string `json:"description"`
+
+	// Draft Creates the article as a draft instead of publishing it
+	Draft *bool `json:"draft,omitempty"`
+
+	// PublishAt Schedules the publication of the article, in the future
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+	TagList   *[]string  `json:"tagList,omitempty"`
+	Title     string     `json:"title"`
 }
 
 // NewComment defines model for NewComment.
 type NewComment struct {
 	Body string `json:"body"`
+
+	// ParentId Id of the comment to reply to
+	ParentId *int `json:"parentId,omitempty"`
 }
 
+// NewPersonalAccessToken defines model for NewPersonalAccessToken.
+type NewPersonalAccessToken struct {
+	// ExpiresAt Defaults to a year from now
+	ExpiresAt *time.Time                     `json:"expiresAt,omitempty"`
+	Name      string                         `json:"name"`
+	Scopes    []NewPersonalAccessTokenScopes `json:"scopes"`
+}
+
+// NewPersonalAccessTokenScopes defines model for NewPersonalAccessToken.Scopes.
+type NewPersonalAccessTokenScopes string
+
 // NewUser defines model for NewUser.
 type NewUser struct {
 	Email    string `json:"email"`
@@ -67,19 +201,72 @@ type NewUser struct {
 	Username string `json:"username"`
 }
 
+// NextCursor Opaque cursor of the next page, absent on the last page
+type NextCursor = string
+
+// PersonalAccessToken defines model for PersonalAccessToken.
+type PersonalAccessToken struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	Scopes    []string           `json:"scopes"`
+
+	// Token The token itself, only returned on creation
+	Token *string `json:"token,omitempty"`
+}
+
+// ProblemDetails An RFC 7807 problem, returned unless the legacy error format is configured
+type ProblemDetails struct {
+	Detail        *string         `json:"detail,omitempty"`
+	Instance      *string         `json:"instance,omitempty"`
+	InvalidParams *[]InvalidParam `json:"invalid_params,omitempty"`
+	Status        int             `json:"status"`
+	Title         string          `json:"title"`
+	TraceId       *string         `json:"trace_id,omitempty"`
+	Type          string          `json:"type"`
+}
+
 // Profile defines model for Profile.
 type Profile struct {
-	Bio       string `json:"bio"`
-	Following bool   `json:"following"`
-	Image     string `json:"image"`
-	Username  string `json:"username"`
+	// ArticlesCount Number of published articles
+	ArticlesCount  int    `json:"articlesCount"`
+	Bio            string `json:"bio"`
+	FollowersCount int    `json:"followersCount"`
+	Following      bool   `json:"following"`
+	FollowingCount int    `json:"followingCount"`
+	Image          string `json:"image"`
+	Username       string `json:"username"`
+}
+
+// RefreshToken defines model for RefreshToken.
+type RefreshToken struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// TableOfContentsEntry defines model for TableOfContentsEntry.
+type TableOfContentsEntry struct {
+	// Anchor Id of the heading in bodyHtml
+	Anchor string `json:"anchor"`
+
+	// Level Level of the heading, from 1 to 6
+	Level int    `json:"level"`
+	Text  string `json:"text"`
 }
 
 // UpdateArticle defines model for UpdateArticle.
 type UpdateArticle struct {
 	Body        *string `json:"body,omitempty"`
 	Description *string `json:"description,omitempty"`
-	Title       *string `json:"title,omitempty"`
+
+	// TagList Replaces all the tags of the article, an empty list removes them
+	TagList *[]string `json:"tagList,omitempty"`
+	Title   *string   `json:"title,omitempty"`
+}
+
+// UpdateComment defines model for UpdateComment.
+type UpdateComment struct {
+	Body string `json:"body"`
 }
 
 // UpdateUser defines model for UpdateUser.
@@ -93,43 +280,134 @@ type UpdateUser struct {
 
 // User defines model for User.
 type User struct {
-	Bio      string `json:"bio"`
-	Email    string `json:"email"`
-	Image    string `json:"image"`
-	Token    string `json:"token"`
-	Username string `json:"username"`
+	Bio          string  `json:"bio"`
+	Email        string  `json:"email"`
+	Image        string  `json:"image"`
+	RefreshToken *string `json:"refreshToken,omitempty"`
+	Token        string  `json:"token"`
+	Username     string  `json:"username"`
 }
 
+// CursorParam defines model for cursorParam.
+type CursorParam = string
+
+// IfMatchParam defines model for ifMatchParam.
+type IfMatchParam = string
+
+// IfNoneMatchParam defines model for ifNoneMatchParam.
+type IfNoneMatchParam = string
+
 // LimitParam defines model for limitParam.
 type LimitParam = int
 
 // OffsetParam defines model for offsetParam.
 type OffsetParam = int
 
-// GenericError defines model for GenericError.
-type GenericError = GenericErrorModel
+// ArticleRevisionDiffResponse defines model for ArticleRevisionDiffResponse.
+type ArticleRevisionDiffResponse struct {
+	Diff ArticleRevisionDiff `json:"diff"`
+}
+
+// ArticleSearchResponse defines model for ArticleSearchResponse.
+type ArticleSearchResponse struct {
+	Articles []ArticleSearchResult `json:"articles"`
+
+	// ArticlesCount total number of articles matching the search, across all pages
+	ArticlesCount int `json:"articlesCount"`
+}
+
+// ConflictApplicationJSON defines model for Conflict.
+type ConflictApplicationJSON = GenericErrorModel
+
+// ConflictApplicationProblemPlusJSON An RFC 7807 problem, returned unless the legacy error format is configured
+type ConflictApplicationProblemPlusJSON = ProblemDetails
+
+// ForbiddenApplicationJSON defines model for Forbidden.
+type ForbiddenApplicationJSON = GenericErrorModel
+
+// ForbiddenApplicationProblemPlusJSON An RFC 7807 problem, returned unless the legacy error format is configured
+type ForbiddenApplicationProblemPlusJSON = ProblemDetails
+
+// GenericErrorApplicationJSON defines model for GenericError.
+type GenericErrorApplicationJSON = GenericErrorModel
+
+// GenericErrorApplicationProblemPlusJSON An RFC 7807 problem, returned unless the legacy error format is configured
+type GenericErrorApplicationProblemPlusJSON = ProblemDetails
+
+// MarkdownPreviewResponse defines model for MarkdownPreviewResponse.
+type MarkdownPreviewResponse struct {
+	BodyHtml string `json:"bodyHtml"`
+}
+
+// MultipleArticleRevisionsResponse defines model for MultipleArticleRevisionsResponse.
+type MultipleArticleRevisionsResponse struct {
+	Revisions []ArticleRevision `json:"revisions"`
+}
 
 // MultipleArticlesResponse defines model for MultipleArticlesResponse.
 type MultipleArticlesResponse struct {
 	Articles []struct {
-		Author         Profile   `json:"author"`
-		CreatedAt      time.Time `json:"createdAt"`
-		Description    string    `json:"description"`
-		Favorited      bool      `json:"favorited"`
-		FavoritesCount int       `json:"favoritesCount"`
-		Slug           string    `json:"slug"`
-		TagList        []string  `json:"tagList"`
-		Title          string    `json:"title"`
-		UpdatedAt      time.Time `json:"updatedAt"`
+		Author Profile `json:"author"`
+
+		// CommentsCount Number of comments and replies, deleted ones excluded
+		CommentsCount int       `json:"commentsCount"`
+		CreatedAt     time.Time `json:"createdAt"`
+
+		// DeletedAt Deletion time of a trashed article
+		DeletedAt      *time.Time `json:"deletedAt,omitempty"`
+		Description    string     `json:"description"`
+		Favorited      bool       `json:"favorited"`
+		FavoritesCount int        `json:"favoritesCount"`
+
+		// ReadingTime Estimated reading time, in minutes
+		ReadingTime     int                    `json:"readingTime"`
+		Slug            string                 `json:"slug"`
+		TableOfContents []TableOfContentsEntry `json:"tableOfContents"`
+		TagList         []string               `json:"tagList"`
+		Title           string                 `json:"title"`
+		UpdatedAt       time.Time              `json:"updatedAt"`
+		WordCount       int                    `json:"wordCount"`
 	} `json:"articles"`
+
+	// ArticlesCount total number of articles matching the filters, across all pages
 	ArticlesCount int `json:"articlesCount"`
+
+	// NextCursor Opaque cursor of the next page, absent on the last page
+	NextCursor *NextCursor `json:"nextCursor,omitempty"`
 }
 
 // MultipleCommentsResponse defines model for MultipleCommentsResponse.
 type MultipleCommentsResponse struct {
 	Comments []Comment `json:"comments"`
+
+	// NextCursor Opaque cursor of the next page, absent on the last page
+	NextCursor *NextCursor `json:"nextCursor,omitempty"`
+}
+
+// MultiplePersonalAccessTokensResponse defines model for MultiplePersonalAccessTokensResponse.
+type MultiplePersonalAccessTokensResponse struct {
+	Tokens []PersonalAccessToken `json:"tokens"`
+}
+
+// MultipleProfilesResponse defines model for MultipleProfilesResponse.
+type MultipleProfilesResponse struct {
+	// NextCursor Opaque cursor of the next page, absent on the last page
+	NextCursor *NextCursor `json:"nextCursor,omitempty"`
+	Profiles   []Profile   `json:"profiles"`
 }
 
+// NotFoundApplicationJSON defines model for NotFound.
+type NotFoundApplicationJSON = GenericErrorModel
+
+// NotFoundApplicationProblemPlusJSON An RFC 7807 problem, returned unless the legacy error format is configured
+type NotFoundApplicationProblemPlusJSON = ProblemDetails
+
+// PreconditionFailedApplicationJSON defines model for PreconditionFailed.
+type PreconditionFailedApplicationJSON = GenericErrorModel
+
+// PreconditionFailedApplicationProblemPlusJSON An RFC 7807 problem, returned unless the legacy error format is configured
+type PreconditionFailedApplicationProblemPlusJSON = ProblemDetails
+
 // ProfileResponse defines model for ProfileResponse.
 type ProfileResponse struct {
 	Profile Profile `json:"profile"`
@@ -140,11 +418,21 @@ type SingleArticleResponse struct {
 	Article Article `json:"article"`
 }
 
+// SingleArticleRevisionResponse defines model for SingleArticleRevisionResponse.
+type SingleArticleRevisionResponse struct {
+	Revision ArticleRevision `json:"revision"`
+}
+
 // SingleCommentResponse defines model for SingleCommentResponse.
 type SingleCommentResponse struct {
 	Comment Comment `json:"comment"`
 }
 
+// SinglePersonalAccessTokenResponse defines model for SinglePersonalAccessTokenResponse.
+type SinglePersonalAccessTokenResponse struct {
+	Token PersonalAccessToken `json:"token"`
+}
+
 // TagsResponse defines model for TagsResponse.
 type TagsResponse struct {
 	Tags []string `json:"tags"`
@@ -155,11 +443,26 @@ type UserResponse struct {
 	User User `json:"user"`
 }
 
+// VersionedArticleResponse defines model for VersionedArticleResponse.
+type VersionedArticleResponse struct {
+	Article Article `json:"article"`
+}
+
+// VersionedUserResponse defines model for VersionedUserResponse.
+type VersionedUserResponse struct {
+	User User `json:"user"`
+}
+
 // LoginUserRequest defines model for LoginUserRequest.
 type LoginUserRequest struct {
 	User LoginUser `json:"user"`
 }
 
+// MarkdownPreviewRequest defines model for MarkdownPreviewRequest.
+type MarkdownPreviewRequest struct {
+	Body string `json:"body"`
+}
+
 // NewArticleRequest defines model for NewArticleRequest.
 type NewArticleRequest struct {
 	Article NewArticle `json:"article"`
@@ -170,16 +473,31 @@ type NewCommentRequest struct {
 	Comment NewComment `json:"comment"`
 }
 
+// NewPersonalAccessTokenRequest defines model for NewPersonalAccessTokenRequest.
+type NewPersonalAccessTokenRequest struct {
+	Token NewPersonalAccessToken `json:"token"`
+}
+
 // NewUserRequest defines model for NewUserRequest.
 type NewUserRequest struct {
 	User NewUser `json:"user"`
 }
 
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	User RefreshToken `json:"user"`
+}
+
 // UpdateArticleRequest defines model for UpdateArticleRequest.
 type UpdateArticleRequest struct {
 	Article UpdateArticle `json:"article"`
 }
 
+// UpdateCommentRequest defines model for UpdateCommentRequest.
+type UpdateCommentRequest struct {
+	Comment UpdateComment `json:"comment"`
+}
+
 // UpdateUserRequest defines model for UpdateUserRequest.
 type UpdateUserRequest struct {
 	User UpdateUser `json:"user"`
@@ -187,22 +505,52 @@ type UpdateUserRequest struct {
 
 // GetArticlesParams defines parameters for GetArticles.
 type GetArticlesParams struct {
-	// Tag Filter by tag
-	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+	// Tag Filter by tag, repeat it to filter by several tags
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// TagMatch Whether articles must have any or all of the tags
+	TagMatch *GetArticlesParamsTagMatch `form:"tagMatch,omitempty" json:"tagMatch,omitempty"`
 
-	// Author Filter by author (username)
-	Author *string `form:"author,omitempty" json:"author,omitempty"`
+	// ExcludeTag Exclude the articles having the tag, repeat it to exclude several tags
+	ExcludeTag *[]string `form:"excludeTag,omitempty" json:"excludeTag,omitempty"`
+
+	// Author Filter by author (username), repeat it to filter by several authors
+	Author *[]string `form:"author,omitempty" json:"author,omitempty"`
 
 	// Favorited Filter by favorites of a user (username)
 	Favorited *string `form:"favorited,omitempty" json:"favorited,omitempty"`
 
+	// CreatedAfter Only the articles created at or after this time
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only the articles created before this time
+	CreatedBefore *time.Time `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+
+	// UpdatedAfter Only the articles updated at or after this time
+	UpdatedAfter *time.Time `form:"updatedAfter,omitempty" json:"updatedAfter,omitempty"`
+
+	// UpdatedBefore Only the articles updated before this time
+	UpdatedBefore *time.Time `form:"updatedBefore,omitempty" json:"updatedBefore,omitempty"`
+
+	// Sort Order of the articles, by their publication date for newest and oldest. Cursors are only supported when sorting by date, the counts sorts are paged with the offset
+	Sort *GetArticlesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
 	// Offset The number of items to skip before starting to collect the result set.
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
 
 	// Limit The numbers of items to return.
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the page starts after it. The offset is ignored when a cursor is given.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetArticlesParamsTagMatch defines parameters for GetArticles.
+type GetArticlesParamsTagMatch string
+
+// GetArticlesParamsSort defines parameters for GetArticles.
+type GetArticlesParamsSort string
+
 // CreateArticleJSONBody defines parameters for CreateArticle.
 type CreateArticleJSONBody struct {
 	Article NewArticle `json:"article"`
@@ -215,6 +563,27 @@ type GetArticlesFeedParams struct {
 
 	// Limit The numbers of items to return.
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the page starts after it. The offset is ignored when a cursor is given.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// SearchArticlesParams defines parameters for SearchArticles.
+type SearchArticlesParams struct {
+	// Q Search query, supports quoted phrases, OR and -excluded words
+	Q string `form:"q" json:"q"`
+
+	// Offset The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The numbers of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetArticleParams defines parameters for GetArticle.
+type GetArticleParams struct {
+	// IfNoneMatch The ETag of a cached version, the response is a 304 while it is current.
+	IfNoneMatch *IfNoneMatchParam `json:"If-None-Match,omitempty"`
 }
 
 // UpdateArticleJSONBody defines parameters for UpdateArticle.
@@ -222,16 +591,121 @@ type UpdateArticleJSONBody struct {
 	Article UpdateArticle `json:"article"`
 }
 
+// UpdateArticleParams defines parameters for UpdateArticle.
+type UpdateArticleParams struct {
+	// IfMatch The ETag of the version the update applies to, the update fails with 412 when the resource has changed since. Only the version of the ETag is compared, a change of the counts or of the flags of the viewer does not fail the update.
+	IfMatch *IfMatchParam `json:"If-Match,omitempty"`
+}
+
+// GetArticleCommentsParams defines parameters for GetArticleComments.
+type GetArticleCommentsParams struct {
+	// Sort Order of the comments of the article, top puts first the ones with the most replies. Cursors are only supported when sorting by date, the top sort is paged with the offset
+	Sort *GetArticleCommentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Offset The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The numbers of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the page starts after it. The offset is ignored when a cursor is given.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetArticleCommentsParamsSort defines parameters for GetArticleComments.
+type GetArticleCommentsParamsSort string
+
 // CreateArticleCommentJSONBody defines parameters for CreateArticleComment.
 type CreateArticleCommentJSONBody struct {
 	Comment NewComment `json:"comment"`
 }
 
+// UpdateArticleCommentJSONBody defines parameters for UpdateArticleComment.
+type UpdateArticleCommentJSONBody struct {
+	Comment UpdateComment `json:"comment"`
+}
+
+// PublishArticleParams defines parameters for PublishArticle.
+type PublishArticleParams struct {
+	// PublishAt Schedules the publication instead of publishing now, in the future
+	PublishAt *time.Time `form:"publishAt,omitempty" json:"publishAt,omitempty"`
+}
+
+// GetArticleRevisionDiffParams defines parameters for GetArticleRevisionDiff.
+type GetArticleRevisionDiffParams struct {
+	// From Number of the revision to diff against, the previous one by default, 0 for the empty article
+	From *int `form:"from,omitempty" json:"from,omitempty"`
+}
+
+// RestoreArticleRevisionParams defines parameters for RestoreArticleRevision.
+type RestoreArticleRevisionParams struct {
+	// IfMatch The ETag of the version the update applies to, the update fails with 412 when the resource has changed since. Only the version of the ETag is compared, a change of the counts or of the flags of the viewer does not fail the update.
+	IfMatch *IfMatchParam `json:"If-Match,omitempty"`
+}
+
+// PreviewMarkdownJSONBody defines parameters for PreviewMarkdown.
+type PreviewMarkdownJSONBody struct {
+	Body string `json:"body"`
+}
+
+// GetProfileFollowersParams defines parameters for GetProfileFollowers.
+type GetProfileFollowersParams struct {
+	// Limit The numbers of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the page starts after it. The offset is ignored when a cursor is given.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetProfileFollowingParams defines parameters for GetProfileFollowing.
+type GetProfileFollowingParams struct {
+	// Limit The numbers of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the page starts after it. The offset is ignored when a cursor is given.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetCurrentUserParams defines parameters for GetCurrentUser.
+type GetCurrentUserParams struct {
+	// IfNoneMatch The ETag of a cached version, the response is a 304 while it is current.
+	IfNoneMatch *IfNoneMatchParam `json:"If-None-Match,omitempty"`
+}
+
 // UpdateCurrentUserJSONBody defines parameters for UpdateCurrentUser.
 type UpdateCurrentUserJSONBody struct {
 	User UpdateUser `json:"user"`
 }
 
+// UpdateCurrentUserParams defines parameters for UpdateCurrentUser.
+type UpdateCurrentUserParams struct {
+	// IfMatch The ETag of the version the update applies to, the update fails with 412 when the resource has changed since. Only the version of the ETag is compared, a change of the counts or of the flags of the viewer does not fail the update.
+	IfMatch *IfMatchParam `json:"If-Match,omitempty"`
+}
+
+// GetDraftArticlesParams defines parameters for GetDraftArticles.
+type GetDraftArticlesParams struct {
+	// Offset The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The numbers of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreatePersonalAccessTokenJSONBody defines parameters for CreatePersonalAccessToken.
+type CreatePersonalAccessTokenJSONBody struct {
+	Token NewPersonalAccessToken `json:"token"`
+}
+
+// GetTrashedArticlesParams defines parameters for GetTrashedArticles.
+type GetTrashedArticlesParams struct {
+	// Offset The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The numbers of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // CreateUserJSONBody defines parameters for CreateUser.
 type CreateUserJSONBody struct {
 	User NewUser `json:"user"`
@@ -242,6 +716,11 @@ type LoginJSONBody struct {
 	User LoginUser `json:"user"`
 }
 
+// RefreshTokenJSONBody defines parameters for RefreshToken.
+type RefreshTokenJSONBody struct {
+	User RefreshToken `json:"user"`
+}
+
 // CreateArticleJSONRequestBody defines body for CreateArticle for application/json ContentType.
 type CreateArticleJSONRequestBody CreateArticleJSONBody
 
@@ -251,11 +730,23 @@ type UpdateArticleJSONRequestBody UpdateArticleJSONBody
 // CreateArticleCommentJSONRequestBody defines body for CreateArticleComment for application/json ContentType.
 type CreateArticleCommentJSONRequestBody CreateArticleCommentJSONBody
 
+// UpdateArticleCommentJSONRequestBody defines body for UpdateArticleComment for application/json ContentType.
+type UpdateArticleCommentJSONRequestBody UpdateArticleCommentJSONBody
+
+// PreviewMarkdownJSONRequestBody defines body for PreviewMarkdown for application/json ContentType.
+type PreviewMarkdownJSONRequestBody PreviewMarkdownJSONBody
+
 // UpdateCurrentUserJSONRequestBody defines body for UpdateCurrentUser for application/json ContentType.
 type UpdateCurrentUserJSONRequestBody UpdateCurrentUserJSONBody
 
+// CreatePersonalAccessTokenJSONRequestBody defines body for CreatePersonalAccessToken for application/json ContentType.
+type CreatePersonalAccessTokenJSONRequestBody CreatePersonalAccessTokenJSONBody
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody CreateUserJSONBody
 
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody LoginJSONBody
+
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody RefreshTokenJSONBody
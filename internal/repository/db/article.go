@@ -2,9 +2,9 @@ package db
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/induzo/gocom/database/pginit/v2"
@@ -17,25 +17,17 @@ import (
 func (r *Repository) GetArticles(
 	ctx context.Context,
 	userID uuid.UUID,
-	author, tag, favorited *string,
+	filter domain.ArticleFilter,
 	limit, offset *int,
-) (
-	[]*domain.Article,
-	error,
-) {
-	query, args := getArticleQuery(userID, nil, author, tag, favorited, false, limit, offset)
+	after *domain.Cursor,
+) (*domain.ArticlePage, error) {
+	builder := newArticleQueryBuilder(userID).withPublished().withFilter(filter).page(limit, offset)
 
-	rows, errR := r.pool.Query(ctx, query, args)
-	if errR != nil {
-		return nil, fmt.Errorf("could not get articles: %w", errR)
-	}
-
-	articles, errA := pgx.CollectRows(rows, pginit.JSONRowToAddrOfStruct[domain.Article])
-	if errA != nil {
-		return nil, fmt.Errorf("could not collect rows: %w", errA)
+	if err := builder.after(after); err != nil {
+		return nil, err
 	}
 
-	return articles, nil
+	return r.getArticlePage(ctx, builder)
 }
 
 func (r *Repository) GetArticle(
@@ -43,149 +35,293 @@ func (r *Repository) GetArticle(
 	userID uuid.UUID,
 	artSlug string,
 ) (*domain.Article, error) {
-	query, args := getArticleQuery(userID, &artSlug, nil, nil, nil, false, nil, nil)
+	query, args := newArticleQueryBuilder(userID).withSlug(artSlug).withVisible().selectQuery()
 
-	rows, errR := r.pool.Query(ctx, query, args)
+	rows, errR := r.conn.Query(ctx, query, args)
 	if errR != nil {
-		return nil, fmt.Errorf("could not get article: %w", errR)
+		return nil, fmt.Errorf("could not get article: %w", domainError(errR))
 	}
 
 	article, errA := pgx.CollectExactlyOneRow(rows, pginit.JSONRowToAddrOfStruct[domain.Article])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect rows: %w", errA)
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errA))
 	}
 
 	return article, nil
 }
 
-func (r Repository) GetFeedArticles(
+// GetDraftArticles lists the draft and scheduled articles of the user, latest updated first.
+func (r *Repository) GetDraftArticles(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset *int,
+) (*domain.ArticlePage, error) {
+	builder := newArticleQueryBuilder(userID).withDrafts().page(limit, offset)
+
+	return r.getArticlePage(ctx, builder)
+}
+
+// GetTrashedArticles lists the trashed articles of the user, latest trashed first.
+func (r *Repository) GetTrashedArticles(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset *int,
+) (*domain.ArticlePage, error) {
+	builder := newArticleQueryBuilder(userID).
+		withTrashed().
+		where("a.author_id = @userID", nil).
+		page(limit, offset)
+
+	return r.getArticlePage(ctx, builder)
+}
+
+func (r *Repository) GetArticleAuthorID(ctx context.Context, artSlug string) (uuid.UUID, error) {
+	sql := `SELECT author_id FROM article WHERE slug = @slug AND deleted_at IS NULL`
+
+	var authorID uuid.UUID
+	if err := r.conn.QueryRow(ctx, sql, pgx.NamedArgs{"slug": artSlug}).Scan(&authorID); err != nil {
+		return uuid.Nil, fmt.Errorf("could not get article author: %w", domainError(err))
+	}
+
+	return authorID, nil
+}
+
+func (r *Repository) GetFeedArticles(
+	ctx context.Context,
+	userID uuid.UUID,
+	filter domain.ArticleFilter,
+	limit, offset *int,
+	after *domain.Cursor,
+) (*domain.ArticlePage, error) {
+	builder := newArticleQueryBuilder(userID).
+		withPublished().
+		withFeed().
+		withFilter(filter).
+		page(limit, offset)
+
+	if err := builder.after(after); err != nil {
+		return nil, err
+	}
+
+	return r.getArticlePage(ctx, builder)
+}
+
+func (r *Repository) SearchArticles(
 	ctx context.Context,
 	userID uuid.UUID,
-	author, tag, favorited *string,
+	query string,
 	limit, offset *int,
-) ([]*domain.Article, error) {
-	query, args := getArticleQuery(userID, nil, author, tag, favorited, true, limit, offset)
+) (*domain.ArticleSearchPage, error) {
+	builder := newArticleQueryBuilder(userID).
+		withPublished().
+		withSearch(query, r.searchLanguage).
+		page(limit, offset)
 
-	rows, errR := r.pool.Query(ctx, query, args)
+	results, total, err := queryArticlePage[domain.ArticleSearchResult](ctx, r, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range results {
+		res.TitleHighlight = highlightHTML(res.TitleHighlight)
+		res.Snippet = highlightHTML(res.Snippet)
+	}
+
+	return &domain.ArticleSearchPage{
+		Results: results,
+		Total:   total,
+	}, nil
+}
+
+// getArticlePage gets a page of the filtered articles and their total count in one round trip.
+func (r *Repository) getArticlePage(
+	ctx context.Context,
+	builder *articleQueryBuilder,
+) (*domain.ArticlePage, error) {
+	articles, total, err := queryArticlePage[domain.Article](ctx, r, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ArticlePage{
+		Articles: articles,
+		Total:    total,
+	}, nil
+}
+
+// queryArticlePage sends the listing and the count of the builder in one batch.
+func queryArticlePage[T any](
+	ctx context.Context,
+	r *Repository,
+	builder *articleQueryBuilder,
+) ([]*T, int, error) {
+	query, args := builder.selectQuery()
+	countQuery, countArgs := builder.countQuery()
+
+	batch := &pgx.Batch{}
+	batch.Queue(query, args)
+	batch.Queue(countQuery, countArgs)
+
+	batchRes := r.conn.SendBatch(ctx, batch)
+	defer batchRes.Close()
+
+	rows, errR := batchRes.Query()
 	if errR != nil {
-		return nil, fmt.Errorf("could not get articles: %w", errR)
+		return nil, 0, fmt.Errorf("could not get articles: %w", domainError(errR))
 	}
 
-	articles, errA := pgx.CollectRows(rows, pginit.JSONRowToAddrOfStruct[domain.Article])
+	items, errA := pgx.CollectRows(rows, pginit.JSONRowToAddrOfStruct[T])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect rows: %w", errA)
+		return nil, 0, fmt.Errorf("could not collect rows: %w", domainError(errA))
 	}
 
-	return articles, nil
+	var total int
+	if err := batchRes.QueryRow().Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("could not count articles: %w", domainError(err))
+	}
+
+	return items, total, nil
 }
 
 func (r *Repository) CreateArticle(
 	ctx context.Context,
 	userID uuid.UUID,
+	artSlug,
 	title,
 	description,
 	body string,
 	tagList []string,
+	status domain.ArticleStatus,
+	publishAt *time.Time,
+	metadata domain.ArticleMetadata,
 ) (*domain.Article, error) {
-	// create the article
-	if err := r.createArticle(ctx, userID, title, description, body, tagList); err != nil {
-		return nil, fmt.Errorf("could not create article: %w", err)
-	}
+	// the article is created with its tags, or not at all
+	return withTxResult(ctx, r, func(repo *Repository) (*domain.Article, error) {
+		if err := repo.createArticle(
+			ctx, userID, artSlug, title, description, body, tagList, status, publishAt, metadata,
+		); err != nil {
+			return nil, fmt.Errorf("could not create article: %w", domainError(err))
+		}
 
-	// get the article
-	article, err := r.GetArticle(ctx, userID, domain.GetSlugFromTitle(title))
-	if err != nil {
-		return nil, fmt.Errorf("could not get article after creation: %w", err)
-	}
+		article, err := repo.GetArticle(ctx, userID, artSlug)
+		if err != nil {
+			return nil, fmt.Errorf("could not get article after creation: %w", domainError(err))
+		}
 
-	return article, nil
+		return article, nil
+	})
 }
 
 func (r *Repository) createArticle(
 	ctx context.Context,
 	userID uuid.UUID,
+	articleSlug,
 	title,
 	description,
 	body string,
 	tagList []string,
+	status domain.ArticleStatus,
+	publishAt *time.Time,
+	metadata domain.ArticleMetadata,
 ) error {
 	articleID, errU := uuid.NewV7()
 	if errU != nil {
 		return fmt.Errorf("could not generate uuid: %w", errU)
 	}
 
-	articleSlug := domain.GetSlugFromTitle(title)
-
-	tagList = removeDuplicates(tagList)
-
-	tagIDs, tagNames, errT := getTagValues(tagList)
-	if errT != nil {
-		return fmt.Errorf("could not generate uuid: %w", errT)
-	}
-
-	var errTx error
-
 	// Create a batch
 	batch := &pgx.Batch{}
 
 	// add article insert to the batch
 	batch.Queue(`
-		INSERT INTO article (id, slug, title, description, body, author_id)
-		VALUES (@articleID, @slug, @title, @description, @body, @userID);`,
+		INSERT INTO article (
+			id, slug, title, description, body, author_id, search_language, published_at, publish_at,
+			word_count, reading_time, table_of_contents
+		)
+		VALUES (
+			@articleID, @slug, @title, @description, @body, @userID, @searchLanguage::regconfig,
+			CASE WHEN @published::boolean THEN NOW() END, @publishAt,
+			@wordCount, @readingTime, COALESCE(@tableOfContents::jsonb, '[]')
+		);`,
+		pgx.NamedArgs{
+			"articleID":       articleID,
+			"slug":            articleSlug,
+			"title":           title,
+			"description":     description,
+			"body":            body,
+			"userID":          userID,
+			"searchLanguage":  r.searchLanguage,
+			"published":       status == domain.ArticleStatusPublished,
+			"publishAt":       publishAt,
+			"wordCount":       metadata.WordCount,
+			"readingTime":     metadata.ReadingTime,
+			"tableOfContents": metadata.TableOfContents,
+		},
+	)
+
+	// reserve the slug, failing if another article ever had it
+	batch.Queue(`
+		INSERT INTO article_slug_history (slug, article_id)
+		VALUES (@slug, @articleID)`,
 		pgx.NamedArgs{
-			"articleID":   articleID,
-			"slug":        articleSlug,
-			"title":       title,
-			"description": description,
-			"body":        body,
-			"userID":      userID,
+			"slug":      articleSlug,
+			"articleID": articleID,
 		},
 	)
 
-	// add tag insert to the batch
+	queueArticleRevision(batch, articleID, userID)
+
+	if err := queueArticleTags(batch, articleID, tagList); err != nil {
+		return err
+	}
+
+	// execute the batch, close returns the error of the first failed statement
+	if err := r.conn.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("could not exec batch: %w", domainError(err))
+	}
+
+	return nil
+}
+
+// queueArticleTags replaces the tags of the article with the tag list, creating the missing tags.
+// The upsert locks the existing tags so that the garbage collection cannot delete them meanwhile.
+func queueArticleTags(batch *pgx.Batch, articleID uuid.UUID, tagList []string) error {
+	tagIDs, tagNames, errT := getTagValues(removeDuplicates(tagList))
+	if errT != nil {
+		return fmt.Errorf("could not generate uuid: %w", domainError(errT))
+	}
+
 	batch.Queue(`
 		INSERT INTO tag (id, name)
 		SELECT id, name FROM UNNEST(@tagIDs::uuid[], @tagNames::text[]) AS t(id, name)
-		ON CONFLICT (name) DO NOTHING`,
+		ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name`,
 		pgx.NamedArgs{
 			"tagIDs":   tagIDs,
 			"tagNames": tagNames,
 		},
 	)
 
-	// associate article with tags
 	batch.Queue(`
-		INSERT INTO article_tag (article_id, tag_id)
-		SELECT @articleID, id FROM tag WHERE name = ANY(@tagNames)`,
+		DELETE FROM article_tag
+		WHERE article_id = @articleID
+		AND tag_id NOT IN (SELECT id FROM tag WHERE name = ANY(@tagNames))`,
 		pgx.NamedArgs{
 			"articleID": articleID,
 			"tagNames":  tagNames,
 		},
 	)
 
-	// execute the batch
-	batchRes := r.pool.SendBatch(ctx, batch)
-
-	// check for errors
-	if _, err := batchRes.Exec(); err != nil {
-		errTx = errors.Join(errTx, fmt.Errorf("could not exec batch: %w", err))
-
-		return errTx
-	}
-
-	// close the batch
-	if err := batchRes.Close(); err != nil {
-		errTx = errors.Join(errTx, fmt.Errorf("could not close batch: %w", err))
-
-		return errTx
-	}
-
-	tags := make([]domain.Tag, len(tagList))
-	for idx, tag := range tagList {
-		tags[idx] = domain.Tag(tag)
-	}
+	batch.Queue(`
+		INSERT INTO article_tag (article_id, tag_id)
+		SELECT @articleID, id FROM tag WHERE name = ANY(@tagNames)
+		ON CONFLICT DO NOTHING`,
+		pgx.NamedArgs{
+			"articleID": articleID,
+			"tagNames":  tagNames,
+		},
+	)
 
-	return errTx
+	return nil
 }
 
 func getTagValues(tags []string) ([]uuid.UUID, []string, error) {
@@ -226,14 +362,57 @@ func (r *Repository) UpdateArticle(
 	ctx context.Context,
 	userID uuid.UUID,
 	artSlug string,
-	title, description, body *string,
+	newSlug, title, description, body *string,
+	tagList *[]string,
+	metadata *domain.ArticleMetadata,
+	expectedVersions []int,
+) (*domain.Article, error) {
+	return withTxResult(ctx, r, func(repo *Repository) (*domain.Article, error) {
+		return repo.updateArticle(
+			ctx, userID, artSlug, newSlug, title, description, body, tagList, metadata, expectedVersions,
+		)
+	})
+}
+
+func (r *Repository) updateArticle(
+	ctx context.Context,
+	userID uuid.UUID,
+	artSlug string,
+	newSlug, title, description, body *string,
+	tagList *[]string,
+	metadata *domain.ArticleMetadata,
+	expectedVersions []int,
 ) (*domain.Article, error) {
+	// lock the article until the transaction ends, its version cannot change meanwhile
+	var (
+		articleID uuid.UUID
+		version   int
+	)
+
+	if err := r.conn.QueryRow(
+		ctx,
+		`SELECT id, version FROM article
+		WHERE slug = @slug AND author_id = @userID AND deleted_at IS NULL
+		FOR UPDATE`,
+		pgx.NamedArgs{"slug": artSlug, "userID": userID},
+	).Scan(&articleID, &version); err != nil {
+		return nil, fmt.Errorf("could not get article to update: %w", domainError(err))
+	}
+
+	if !domain.VersionMatches(version, expectedVersions) {
+		return nil, fmt.Errorf("could not update article: %w", domain.ErrVersionMismatch)
+	}
+
 	updateParams := pgx.NamedArgs{
-		"slug":   artSlug,
-		"userID": userID,
+		"articleID": articleID,
 	}
 
-	updateFields := []string{}
+	updateFields := []string{`updated_at = NOW()`, `version = version + 1`}
+
+	if newSlug != nil {
+		updateFields = append(updateFields, `slug = @newSlug`)
+		updateParams["newSlug"] = newSlug
+	}
 
 	if title != nil {
 		updateFields = append(updateFields, `title = @title`)
@@ -250,201 +429,251 @@ func (r *Repository) UpdateArticle(
 		updateParams["body"] = body
 	}
 
-	sql := fmt.Sprintf(`
-		UPDATE article
-		SET %s
-		WHERE slug = @slug
-		AND author_id = @userID`,
-		strings.Join(updateFields, ", "),
+	if metadata != nil {
+		updateFields = append(
+			updateFields,
+			`word_count = @wordCount`,
+			`reading_time = @readingTime`,
+			`table_of_contents = COALESCE(@tableOfContents::jsonb, '[]')`,
+		)
+		updateParams["wordCount"] = metadata.WordCount
+		updateParams["readingTime"] = metadata.ReadingTime
+		updateParams["tableOfContents"] = metadata.TableOfContents
+	}
+
+	// the statements are sent in one round trip
+	batch := &pgx.Batch{}
+
+	if newSlug != nil {
+		// reserve the new slug, unless the article already had it
+		batch.Queue(`
+			INSERT INTO article_slug_history (slug, article_id)
+			SELECT @newSlug, @articleID
+			WHERE NOT EXISTS(
+				SELECT 1
+				FROM article_slug_history
+				WHERE slug = @newSlug
+				AND article_id = @articleID
+			)`,
+			updateParams,
+		)
+	}
+
+	batch.Queue(
+		fmt.Sprintf(`UPDATE article SET %s WHERE id = @articleID`, strings.Join(updateFields, ", ")),
+		updateParams,
 	)
 
-	_, err := r.pool.Exec(ctx, sql, updateParams)
-	if err != nil {
-		return nil, fmt.Errorf("could not update article: %w", err)
+	// the tags are not part of the revisions
+	if title != nil || description != nil || body != nil {
+		queueArticleRevision(batch, articleID, userID)
+	}
+
+	if tagList != nil {
+		if err := queueArticleTags(batch, articleID, *tagList); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.conn.SendBatch(ctx, batch).Close(); err != nil {
+		return nil, fmt.Errorf("could not update article: %w", domainError(err))
+	}
+
+	if newSlug != nil {
+		artSlug = *newSlug
 	}
 
 	return r.GetArticle(ctx, userID, artSlug)
 }
 
+// GetArticleCurrentSlug returns the current slug of the article that had the slug,
+// if the user can see the article.
+func (r *Repository) GetArticleCurrentSlug(ctx context.Context, userID uuid.UUID, artSlug string) (string, error) {
+	sql := `
+		SELECT a.slug
+		FROM article_slug_history h
+		JOIN article a ON a.id = h.article_id
+		WHERE h.slug = @slug
+		AND a.deleted_at IS NULL
+		AND (a.published_at IS NOT NULL OR a.author_id = @userID)`
+
+	args := pgx.NamedArgs{"slug": artSlug, "userID": userID}
+
+	var currentSlug string
+	if err := r.conn.QueryRow(ctx, sql, args).Scan(&currentSlug); err != nil {
+		return "", fmt.Errorf("could not get article current slug: %w", domainError(err))
+	}
+
+	return currentSlug, nil
+}
+
+// DeleteArticle moves the article to the trash of its author,
+// where it is kept with its comments, favorites and tags until it is restored or purged.
 func (r *Repository) DeleteArticle(ctx context.Context, userID uuid.UUID, artSlug string) error {
-	sql := `DELETE FROM article WHERE slug = @slug AND author_id = @userID`
+	sql := `
+		UPDATE article
+		SET deleted_at = NOW(), version = version + 1
+		WHERE slug = @slug
+		AND author_id = @userID
+		AND deleted_at IS NULL`
 
-	_, err := r.pool.Exec(ctx, sql, pgx.NamedArgs{"slug": artSlug, "userID": userID})
-	if err != nil {
-		return fmt.Errorf("could not delete article: %w", err)
-	}
+	return r.WithTx(ctx, func(repo *Repository) error {
+		if _, err := repo.conn.Exec(ctx, sql, pgx.NamedArgs{"slug": artSlug, "userID": userID}); err != nil {
+			return fmt.Errorf("could not delete article: %w", domainError(err))
+		}
 
-	return nil
+		return nil
+	})
 }
 
-func (r *Repository) FavoriteArticle(
+// RestoreArticle takes the article out of the trash of the user.
+func (r *Repository) RestoreArticle(
 	ctx context.Context,
 	userID uuid.UUID,
 	artSlug string,
 ) (*domain.Article, error) {
 	sql := `
-		INSERT INTO article_favorite (article_id, appuser_id) VALUES
-		((SELECT id FROM article WHERE slug = @slug), @userID)
-	`
+		UPDATE article
+		SET deleted_at = NULL, version = version + 1
+		WHERE slug = @slug
+		AND author_id = @userID
+		AND deleted_at IS NOT NULL`
+
+	return withTxResult(ctx, r, func(repo *Repository) (*domain.Article, error) {
+		cmdTag, err := repo.conn.Exec(ctx, sql, pgx.NamedArgs{"slug": artSlug, "userID": userID})
+		if err != nil {
+			return nil, fmt.Errorf("could not restore article: %w", domainError(err))
+		}
+
+		if cmdTag.RowsAffected() == 0 {
+			return nil, fmt.Errorf("could not restore article: %w", domain.ErrTrashedArticleNotFound)
+		}
+
+		return repo.GetArticle(ctx, userID, artSlug)
+	})
+}
+
+// PurgeTrashedArticles deletes for good the articles trashed for longer than the retention,
+// along with their comments, favorites and tags, and returns how many were deleted.
+func (r *Repository) PurgeTrashedArticles(ctx context.Context, retention time.Duration) (int64, error) {
+	sql := `DELETE FROM article WHERE deleted_at < @trashedBefore`
 
-	_, err := r.pool.Exec(ctx, sql, pgx.NamedArgs{"slug": artSlug, "userID": userID})
+	cmdTag, err := r.conn.Exec(ctx, sql, pgx.NamedArgs{"trashedBefore": time.Now().Add(-retention)})
 	if err != nil {
-		return nil, fmt.Errorf("could not favorite article: %w", err)
+		return 0, fmt.Errorf("could not purge trashed articles: %w", domainError(err))
 	}
 
-	return r.GetArticle(ctx, userID, artSlug)
+	return cmdTag.RowsAffected(), nil
 }
 
-func (r *Repository) UnfavoriteArticle(
+// PublishArticle publishes the article now, keeping the date of a former publication,
+// or schedules it at publishAt. A published article cannot be scheduled.
+func (r *Repository) PublishArticle(
 	ctx context.Context,
 	userID uuid.UUID,
 	artSlug string,
+	publishAt *time.Time,
 ) (*domain.Article, error) {
 	sql := `
-		DELETE FROM article_favorite
-		WHERE article_id = (SELECT id FROM article WHERE slug = @slug)
-		AND appuser_id = @userID
-	`
+		UPDATE article
+		SET published_at = CASE WHEN @publishAt::timestamptz IS NULL THEN COALESCE(published_at, NOW()) END,
+		publish_at = @publishAt,
+		version = version + 1
+		WHERE slug = @slug
+		AND author_id = @userID
+		AND deleted_at IS NULL
+		AND (@publishAt::timestamptz IS NULL OR published_at IS NULL)`
 
-	_, err := r.pool.Exec(ctx, sql, pgx.NamedArgs{"slug": artSlug, "userID": userID})
+	args := pgx.NamedArgs{"slug": artSlug, "userID": userID, "publishAt": publishAt}
+
+	cmdTag, err := r.conn.Exec(ctx, sql, args)
 	if err != nil {
-		return nil, fmt.Errorf("could not unfavorite article: %w", err)
+		return nil, fmt.Errorf("could not publish article: %w", domainError(err))
 	}
 
-	return r.GetArticle(ctx, userID, artSlug)
+	article, err := r.GetArticle(ctx, userID, artSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmdTag.RowsAffected() == 0 && article.Status == domain.ArticleStatusPublished {
+		return nil, fmt.Errorf("could not publish article: %w", domain.ErrArticlePublished)
+	}
+
+	return article, nil
 }
 
-func getArticleQuery(
+// UnpublishArticle turns the article back into a draft.
+func (r *Repository) UnpublishArticle(
+	ctx context.Context,
 	userID uuid.UUID,
-	artSlug, author, tag, favorited *string,
-	feed bool,
-	limit, offset *int,
-) (string, pgx.NamedArgs) {
-	query := `
-		SELECT
-			JSON_BUILD_OBJECT(
-				'id', a.id,
-				'slug', a.slug,
-				'title', a.title,
-				'description', a.description,
-				'body', a.body,
-				'tag_list', (
-					SELECT JSON_AGG(name ORDER BY name)
-					FROM article_tag
-					JOIN tag ON article_tag.tag_id = tag.id
-					WHERE article_id = a.id
-				),
-				'created_at', a.created_at,
-				'updated_at', a.updated_at,
-				'favorited', EXISTS(
-					SELECT 1
-					FROM article_favorite
-					WHERE article_id = a.id
-					AND appuser_id = @userID
-				),
-				'favorites_count', (
-						SELECT COUNT(*)
-						FROM article_favorite
-						WHERE article_id = a.id
-				),
-				'author', (
-					SELECT JSON_BUILD_OBJECT(
-						'username', u.username,
-						'bio', u.bio,
-						'img', u.img,
-						'following', EXISTS(
-							SELECT 1
-							FROM appuser_follows
-							WHERE follower_id = @userID
-							AND followee_id = u.id
-						)
-					)
-					FROM appuser u
-					WHERE u.id = a.author_id
-				)
-			)
-		FROM article a
-	`
-
-	queryFilter := []string{}
+	artSlug string,
+) (*domain.Article, error) {
+	sql := `
+		UPDATE article
+		SET published_at = NULL, publish_at = NULL, version = version + 1
+		WHERE slug = @slug
+		AND author_id = @userID
+		AND deleted_at IS NULL`
 
-	queryArgs := pgx.NamedArgs{
-		"userID": userID,
+	if _, err := r.conn.Exec(ctx, sql, pgx.NamedArgs{"slug": artSlug, "userID": userID}); err != nil {
+		return nil, fmt.Errorf("could not unpublish article: %w", domainError(err))
 	}
 
-	// filters
-	if artSlug != nil {
-		queryFilter = append(queryFilter, "a.slug = @slug")
-		queryArgs["slug"] = artSlug
-	}
+	return r.GetArticle(ctx, userID, artSlug)
+}
 
-	if tag != nil {
-		queryFilter = append(
-			queryFilter,
-			`EXISTS(
-				SELECT 1
-				FROM article_tag
-				JOIN tag ON article_tag.tag_id = tag.id
-				WHERE article_id = a.id AND tag.name = @tag
-			)`,
-		)
-		queryArgs["tag"] = tag
-	}
+// PublishScheduledArticles publishes the articles whose publication time has come,
+// dated of their publication time, and returns how many were published.
+func (r *Repository) PublishScheduledArticles(ctx context.Context) (int64, error) {
+	sql := `
+		UPDATE article
+		SET published_at = publish_at, publish_at = NULL, version = version + 1
+		WHERE published_at IS NULL
+		AND publish_at <= NOW()
+		AND deleted_at IS NULL`
 
-	if author != nil {
-		queryFilter = append(
-			queryFilter,
-			`EXISTS(
-				SELECT 1
-				FROM appuser
-				WHERE username = @author
-				AND id = a.author_id
-			)`,
-		)
-		queryArgs["author"] = author
+	cmdTag, err := r.conn.Exec(ctx, sql)
+	if err != nil {
+		return 0, fmt.Errorf("could not publish scheduled articles: %w", domainError(err))
 	}
 
-	if favorited != nil {
-		queryFilter = append(
-			queryFilter,
-			`EXISTS(
-				SELECT 1
-				FROM article_favorite af
-				JOIN appuser au ON af.appuser_id = au.id
-				WHERE au.username = @favoritedUsername
-			)`,
-		)
+	return cmdTag.RowsAffected(), nil
+}
 
-		queryArgs["favoritedUsername"] = favorited
-	}
+func (r *Repository) FavoriteArticle(
+	ctx context.Context,
+	userID uuid.UUID,
+	artSlug string,
+) (*domain.Article, error) {
+	sql := `
+		INSERT INTO article_favorite (article_id, appuser_id) VALUES
+		((SELECT id FROM article WHERE slug = @slug AND published_at IS NOT NULL AND deleted_at IS NULL), @userID)
+	`
 
-	if feed {
-		queryFilter = append(
-			queryFilter,
-			`EXISTS(
-				SELECT 1
-				FROM appuser_follows
-				WHERE follower_id = @userID
-				AND followee_id = a.author_id
-			)`,
-		)
+	_, err := r.conn.Exec(ctx, sql, pgx.NamedArgs{"slug": artSlug, "userID": userID})
+	if err != nil {
+		return nil, fmt.Errorf("could not favorite article: %w", domainError(err))
 	}
 
-	// filter
-	if len(queryFilter) > 0 {
-		query += "\nWHERE " + strings.Join(queryFilter, "\n AND ")
-	}
+	return r.GetArticle(ctx, userID, artSlug)
+}
 
-	// pagination
-	if limit != nil {
-		query += " LIMIT @limit"
-		queryArgs["limit"] = limit
+func (r *Repository) UnfavoriteArticle(
+	ctx context.Context,
+	userID uuid.UUID,
+	artSlug string,
+) (*domain.Article, error) {
+	sql := `
+		DELETE FROM article_favorite
+		WHERE article_id = (SELECT id FROM article WHERE slug = @slug AND deleted_at IS NULL)
+		AND appuser_id = @userID
+	`
 
-		if offset != nil {
-			query += " OFFSET @offset"
-			queryArgs["offset"] = offset
-		}
+	_, err := r.conn.Exec(ctx, sql, pgx.NamedArgs{"slug": artSlug, "userID": userID})
+	if err != nil {
+		return nil, fmt.Errorf("could not unfavorite article: %w", domainError(err))
 	}
 
-	return query, queryArgs
+	return r.GetArticle(ctx, userID, artSlug)
 }
@@ -0,0 +1,453 @@
+package db
+
+import (
+	"fmt"
+	"html"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"realworld/internal/domain"
+)
+
+// articleJSON is the article as seen by the user, with its favorited and following flags
+const articleJSON = `
+		JSON_BUILD_OBJECT(
+			'id', a.id,
+			'slug', a.slug,
+			'title', a.title,
+			'description', a.description,
+			'body', a.body,
+			'tag_list', (
+				SELECT JSON_AGG(name ORDER BY name)
+				FROM article_tag
+				JOIN tag ON article_tag.tag_id = tag.id
+				WHERE article_id = a.id
+			),
+			'created_at', a.created_at,
+			'updated_at', a.updated_at,
+			'version', a.version,
+			'status', CASE
+				WHEN a.published_at IS NOT NULL THEN 'published'
+				WHEN a.publish_at IS NOT NULL THEN 'scheduled'
+				ELSE 'draft'
+			END,
+			'publish_at', a.publish_at,
+			'published_at', a.published_at,
+			'deleted_at', a.deleted_at,
+			'word_count', a.word_count,
+			'reading_time', a.reading_time,
+			'table_of_contents', a.table_of_contents,
+			'favorited', EXISTS(
+				SELECT 1
+				FROM article_favorite
+				WHERE article_id = a.id
+				AND appuser_id = @userID
+			),
+			'favorites_count', (
+					SELECT COUNT(*)
+					FROM article_favorite
+					WHERE article_id = a.id
+			),
+			'comments_count', (
+					SELECT COUNT(*)
+					FROM comment
+					WHERE article_id = a.id
+					AND deleted_at IS NULL
+			),
+			'author', (
+				SELECT JSON_BUILD_OBJECT(
+					'username', u.username,
+					'bio', u.bio,
+					'img', u.img,` + profileCountsJSON + `
+					'following', EXISTS(
+						SELECT 1
+						FROM appuser_follows
+						WHERE follower_id = @userID
+						AND followee_id = u.id
+					)
+				)
+				FROM appuser u
+				WHERE u.id = a.author_id
+			)
+		)
+`
+
+// articleSearchJSON adds the rank and the highlights of a full-text search.
+const articleSearchJSON = `
+	JSONB_BUILD_OBJECT(
+		'rank', ts_rank_cd(a.search_vector, websearch_to_tsquery(@searchLanguage::regconfig, @searchQuery)),
+		'title_highlight', ts_headline(
+			a.search_language,
+			a.title,
+			websearch_to_tsquery(@searchLanguage::regconfig, @searchQuery),
+			@titleHighlightOptions
+		),
+		'snippet', ts_headline(
+			a.search_language,
+			a.body,
+			websearch_to_tsquery(@searchLanguage::regconfig, @searchQuery),
+			@snippetOptions
+		)
+	)
+`
+
+// the matches are delimited with private use characters by ts_headline,
+// and turned into <mark> once the text is html escaped.
+const (
+	highlightStart = "\uE000"
+	highlightStop  = "\uE001"
+)
+
+// articleQueryBuilder composes the conditions, the order and the pagination
+// of the article queries. The conditions are shared by the listing and its count,
+// the keyset of a cursor only applies to the listing so that the count stays the total.
+type articleQueryBuilder struct {
+	conditions []string
+	keyset     string
+	args       pgx.NamedArgs
+	sort       domain.ArticleSort
+	published  bool
+	search     bool
+	drafts     bool
+	trashed    bool
+	limit      *int
+	offset     *int
+}
+
+func newArticleQueryBuilder(userID uuid.UUID) *articleQueryBuilder {
+	return &articleQueryBuilder{
+		args: pgx.NamedArgs{
+			"userID": userID,
+		},
+	}
+}
+
+func (b *articleQueryBuilder) where(condition string, args pgx.NamedArgs) *articleQueryBuilder {
+	b.conditions = append(b.conditions, condition)
+	maps.Copy(b.args, args)
+
+	return b
+}
+
+func (b *articleQueryBuilder) withSlug(artSlug string) *articleQueryBuilder {
+	return b.where("a.slug = @slug", pgx.NamedArgs{"slug": artSlug})
+}
+
+// withPublished keeps the published articles, the only ones listed, ordered by their publication.
+func (b *articleQueryBuilder) withPublished() *articleQueryBuilder {
+	b.published = true
+
+	return b.where("a.published_at IS NOT NULL", nil)
+}
+
+// withVisible keeps the published articles and the drafts of the user.
+func (b *articleQueryBuilder) withVisible() *articleQueryBuilder {
+	return b.where("(a.published_at IS NOT NULL OR a.author_id = @userID)", nil)
+}
+
+// withDrafts keeps the articles of the user that are not published yet.
+func (b *articleQueryBuilder) withDrafts() *articleQueryBuilder {
+	b.drafts = true
+
+	return b.where("a.published_at IS NULL AND a.author_id = @userID", nil)
+}
+
+// withTrashed keeps the trashed articles, which every other read hides.
+func (b *articleQueryBuilder) withTrashed() *articleQueryBuilder {
+	b.trashed = true
+
+	return b
+}
+
+// withFilter applies all the conditions and the order of a listing filter.
+func (b *articleQueryBuilder) withFilter(filter domain.ArticleFilter) *articleQueryBuilder {
+	b.withTags(filter.Tags, filter.TagMatch).
+		withoutTags(filter.ExcludedTags).
+		withAuthors(filter.Authors).
+		withCreatedBetween(filter.CreatedAfter, filter.CreatedBefore).
+		withUpdatedBetween(filter.UpdatedAfter, filter.UpdatedBefore)
+
+	if filter.Favorited != nil {
+		b.withFavoritedBy(*filter.Favorited)
+	}
+
+	b.sort = filter.Sort
+
+	return b
+}
+
+// withTags keeps the articles having any, or all, of the tags.
+func (b *articleQueryBuilder) withTags(tags []string, match domain.TagMatch) *articleQueryBuilder {
+	if len(tags) == 0 {
+		return b
+	}
+
+	if match == domain.TagMatchAll {
+		return b.where(
+			`(
+				SELECT COUNT(DISTINCT tag.name)
+				FROM article_tag
+				JOIN tag ON article_tag.tag_id = tag.id
+				WHERE article_id = a.id AND tag.name = ANY(@tags)
+			) = @tagsCount`,
+			pgx.NamedArgs{"tags": tags, "tagsCount": countDistinct(tags)},
+		)
+	}
+
+	return b.where(
+		`EXISTS(
+			SELECT 1
+			FROM article_tag
+			JOIN tag ON article_tag.tag_id = tag.id
+			WHERE article_id = a.id AND tag.name = ANY(@tags)
+		)`,
+		pgx.NamedArgs{"tags": tags},
+	)
+}
+
+func (b *articleQueryBuilder) withoutTags(tags []string) *articleQueryBuilder {
+	if len(tags) == 0 {
+		return b
+	}
+
+	return b.where(
+		`NOT EXISTS(
+			SELECT 1
+			FROM article_tag
+			JOIN tag ON article_tag.tag_id = tag.id
+			WHERE article_id = a.id AND tag.name = ANY(@excludedTags)
+		)`,
+		pgx.NamedArgs{"excludedTags": tags},
+	)
+}
+
+func (b *articleQueryBuilder) withAuthors(authors []string) *articleQueryBuilder {
+	if len(authors) == 0 {
+		return b
+	}
+
+	return b.where(
+		`EXISTS(
+			SELECT 1
+			FROM appuser
+			WHERE username = ANY(@authors)
+			AND id = a.author_id
+		)`,
+		pgx.NamedArgs{"authors": authors},
+	)
+}
+
+// withFavoritedBy keeps the articles favorited by the user.
+func (b *articleQueryBuilder) withFavoritedBy(username string) *articleQueryBuilder {
+	return b.where(
+		`EXISTS(
+			SELECT 1
+			FROM article_favorite af
+			JOIN appuser au ON af.appuser_id = au.id
+			WHERE af.article_id = a.id
+			AND au.username = @favoritedUsername
+		)`,
+		pgx.NamedArgs{"favoritedUsername": username},
+	)
+}
+
+// withSearch keeps the articles matching the web search query, best ranked first.
+func (b *articleQueryBuilder) withSearch(query, language string) *articleQueryBuilder {
+	b.search = true
+
+	selectors := fmt.Sprintf("StartSel=%s, StopSel=%s", highlightStart, highlightStop)
+
+	return b.where(
+		"a.search_vector @@ websearch_to_tsquery(@searchLanguage::regconfig, @searchQuery)",
+		pgx.NamedArgs{
+			"searchQuery":           query,
+			"searchLanguage":        language,
+			"titleHighlightOptions": "HighlightAll=true, " + selectors,
+			"snippetOptions":        "MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter=\" ... \", " + selectors,
+		},
+	)
+}
+
+// withFeed keeps the articles of the authors followed by the user.
+func (b *articleQueryBuilder) withFeed() *articleQueryBuilder {
+	return b.where(
+		`EXISTS(
+			SELECT 1
+			FROM appuser_follows
+			WHERE follower_id = @userID
+			AND followee_id = a.author_id
+		)`,
+		nil,
+	)
+}
+
+func (b *articleQueryBuilder) withCreatedBetween(after, before *time.Time) *articleQueryBuilder {
+	if after != nil {
+		b.where("a.created_at >= @createdAfter", pgx.NamedArgs{"createdAfter": *after})
+	}
+
+	if before != nil {
+		b.where("a.created_at < @createdBefore", pgx.NamedArgs{"createdBefore": *before})
+	}
+
+	return b
+}
+
+func (b *articleQueryBuilder) withUpdatedBetween(after, before *time.Time) *articleQueryBuilder {
+	if after != nil {
+		b.where("a.updated_at >= @updatedAfter", pgx.NamedArgs{"updatedAfter": *after})
+	}
+
+	if before != nil {
+		b.where("a.updated_at < @updatedBefore", pgx.NamedArgs{"updatedBefore": *before})
+	}
+
+	return b
+}
+
+// after restricts the listing to the articles following the cursor, in the order of the sort.
+func (b *articleQueryBuilder) after(cursor *domain.Cursor) error {
+	if cursor == nil {
+		return nil
+	}
+
+	if !b.sort.Keyset() {
+		return fmt.Errorf("could not page %s with a cursor: %w", b.sort, domain.ErrInvalidCursor)
+	}
+
+	cursorID, err := uuid.Parse(cursor.ID)
+	if err != nil {
+		return fmt.Errorf("could not parse cursor: %w", domain.ErrInvalidCursor)
+	}
+
+	b.keyset = "(" + b.dateColumn() + ", a.id) < (@cursorCreatedAt, @cursorID)"
+	if b.sort == domain.ArticleSortOldest {
+		b.keyset = "(" + b.dateColumn() + ", a.id) > (@cursorCreatedAt, @cursorID)"
+	}
+
+	b.args["cursorCreatedAt"] = cursor.CreatedAt
+	b.args["cursorID"] = cursorID
+
+	return nil
+}
+
+// page sets the pagination, the offset is ignored with a cursor.
+func (b *articleQueryBuilder) page(limit, offset *int) *articleQueryBuilder {
+	b.limit = limit
+	b.offset = offset
+
+	return b
+}
+
+func (b *articleQueryBuilder) whereClause(withKeyset bool) string {
+	trash := "a.deleted_at IS NULL"
+	if b.trashed {
+		trash = "a.deleted_at IS NOT NULL"
+	}
+
+	conditions := append([]string{trash}, b.conditions...)
+	if withKeyset && b.keyset != "" {
+		conditions = append(conditions, b.keyset)
+	}
+
+	return "\nWHERE " + strings.Join(conditions, "\n AND ")
+}
+
+// dateColumn is the date the articles are ordered and paged by, the publication
+// of the published articles, a draft published late is then listed as new.
+func (b *articleQueryBuilder) dateColumn() string {
+	if b.published {
+		return "a.published_at"
+	}
+
+	return "a.created_at"
+}
+
+// orderBy breaks the ties with the date and the id so that pages are stable.
+func (b *articleQueryBuilder) orderBy() string {
+	newest := b.dateColumn() + " DESC, a.id DESC"
+
+	if b.trashed {
+		return "\nORDER BY a.deleted_at DESC, a.id DESC"
+	}
+
+	if b.drafts {
+		return "\nORDER BY a.updated_at DESC, a.id DESC"
+	}
+
+	if b.search {
+		return `
+			ORDER BY ts_rank_cd(
+				a.search_vector,
+				websearch_to_tsquery(@searchLanguage::regconfig, @searchQuery)
+			) DESC, ` + newest
+	}
+
+	switch b.sort {
+	case domain.ArticleSortOldest:
+		return "\nORDER BY " + b.dateColumn() + ", a.id"
+	case domain.ArticleSortMostFavorited:
+		return `
+			ORDER BY (
+				SELECT COUNT(*) FROM article_favorite WHERE article_id = a.id
+			) DESC, ` + newest
+	case domain.ArticleSortMostCommented:
+		return `
+			ORDER BY (
+				SELECT COUNT(*) FROM comment WHERE article_id = a.id AND deleted_at IS NULL
+			) DESC, ` + newest
+	case domain.ArticleSortNewest:
+		return "\nORDER BY " + newest
+	default:
+		return "\nORDER BY " + newest
+	}
+}
+
+// selectQuery returns the listing of the articles.
+func (b *articleQueryBuilder) selectQuery() (string, pgx.NamedArgs) {
+	selectExpr := "SELECT" + articleJSON
+	if b.search {
+		selectExpr = "SELECT (" + articleJSON + ")::jsonb || " + articleSearchJSON
+	}
+
+	query := selectExpr + "FROM article a" + b.whereClause(true) + b.orderBy()
+	args := maps.Clone(b.args)
+
+	if b.limit != nil {
+		query += " LIMIT @limit"
+		args["limit"] = *b.limit
+
+		if b.offset != nil && b.keyset == "" {
+			query += " OFFSET @offset"
+			args["offset"] = *b.offset
+		}
+	}
+
+	return query, args
+}
+
+// countQuery returns the number of articles matching the conditions, on all pages.
+func (b *articleQueryBuilder) countQuery() (string, pgx.NamedArgs) {
+	return "SELECT COUNT(*) FROM article a" + b.whereClause(false), b.args
+}
+
+// highlightHTML escapes a ts_headline text and marks its matches.
+func highlightHTML(text string) string {
+	return strings.NewReplacer(
+		highlightStart, "<mark>",
+		highlightStop, "</mark>",
+	).Replace(html.EscapeString(text))
+}
+
+func countDistinct(values []string) int {
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		seen[v] = struct{}{}
+	}
+
+	return len(seen)
+}
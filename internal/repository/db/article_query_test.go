@@ -0,0 +1,226 @@
+package db
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"realworld/internal/domain"
+)
+
+func TestArticleQueryBuilder(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	favorited := "bob"
+	limit := 10
+	offset := 20
+
+	tests := []struct {
+		name         string
+		builder      func() (*articleQueryBuilder, error)
+		wantInQuery  []string
+		wantNotQuery []string
+		wantInCount  []string
+		wantArgs     []string
+	}{
+		{
+			name: "favorited is correlated to the article",
+			builder: func() (*articleQueryBuilder, error) {
+				return newArticleQueryBuilder(uuid.Nil).
+					withFilter(domain.ArticleFilter{Favorited: &favorited}), nil
+			},
+			wantInQuery: []string{"af.article_id = a.id", "au.username = @favoritedUsername"},
+			wantInCount: []string{"af.article_id = a.id"},
+			wantArgs:    []string{"favoritedUsername", "userID"},
+		},
+		{
+			name: "all tags, excluded tags and dates",
+			builder: func() (*articleQueryBuilder, error) {
+				return newArticleQueryBuilder(uuid.Nil).withFilter(domain.ArticleFilter{
+					Tags:         []string{"a", "b", "a"},
+					TagMatch:     domain.TagMatchAll,
+					ExcludedTags: []string{"c"},
+					CreatedAfter: &since,
+				}), nil
+			},
+			wantInQuery: []string{
+				") = @tagsCount",
+				"NOT EXISTS",
+				"a.created_at >= @createdAfter",
+				"ORDER BY a.created_at DESC, a.id DESC",
+			},
+			wantArgs: []string{"tags", "tagsCount", "excludedTags", "createdAfter"},
+		},
+		{
+			name: "cursor ignores the offset and is not counted",
+			builder: func() (*articleQueryBuilder, error) {
+				builder := newArticleQueryBuilder(uuid.Nil).
+					withFilter(domain.ArticleFilter{Sort: domain.ArticleSortOldest}).
+					page(&limit, &offset)
+
+				return builder, builder.after(&domain.Cursor{CreatedAt: since, ID: uuid.NewString()})
+			},
+			wantInQuery: []string{
+				"(a.created_at, a.id) > (@cursorCreatedAt, @cursorID)",
+				"ORDER BY a.created_at, a.id",
+				"LIMIT @limit",
+			},
+			wantNotQuery: []string{"OFFSET"},
+			wantArgs:     []string{"cursorCreatedAt", "cursorID", "limit"},
+		},
+		{
+			name: "published articles are paged by their publication",
+			builder: func() (*articleQueryBuilder, error) {
+				builder := newArticleQueryBuilder(uuid.Nil).withPublished().page(&limit, nil)
+
+				return builder, builder.after(&domain.Cursor{CreatedAt: since, ID: uuid.NewString()})
+			},
+			wantInQuery: []string{
+				"(a.published_at, a.id) < (@cursorCreatedAt, @cursorID)",
+				"ORDER BY a.published_at DESC, a.id DESC",
+			},
+			wantNotQuery: []string{"a.created_at"},
+			wantArgs:     []string{"cursorCreatedAt", "cursorID", "limit"},
+		},
+		{
+			name: "search is ranked and highlighted",
+			builder: func() (*articleQueryBuilder, error) {
+				return newArticleQueryBuilder(uuid.Nil).withSearch("dragon -cat", "english"), nil
+			},
+			wantInQuery: []string{
+				"::jsonb || ",
+				"ts_headline(",
+				"ORDER BY ts_rank_cd(",
+				"a.search_vector @@ websearch_to_tsquery(@searchLanguage::regconfig, @searchQuery)",
+			},
+			wantInCount: []string{"a.search_vector @@"},
+			wantArgs:    []string{"searchQuery", "searchLanguage", "snippetOptions", "titleHighlightOptions"},
+		},
+		{
+			name: "most commented with offset",
+			builder: func() (*articleQueryBuilder, error) {
+				return newArticleQueryBuilder(uuid.Nil).
+					withFilter(domain.ArticleFilter{Sort: domain.ArticleSortMostCommented}).
+					page(&limit, &offset), nil
+			},
+			wantInQuery: []string{"FROM comment WHERE article_id = a.id", "OFFSET @offset"},
+			wantArgs:    []string{"limit", "offset"},
+		},
+		{
+			name: "trashed articles are hidden",
+			builder: func() (*articleQueryBuilder, error) {
+				return newArticleQueryBuilder(uuid.Nil).withSlug("slug"), nil
+			},
+			wantInQuery: []string{"a.deleted_at IS NULL", "a.slug = @slug"},
+			wantInCount: []string{"a.deleted_at IS NULL"},
+		},
+		{
+			name: "trash is latest trashed first",
+			builder: func() (*articleQueryBuilder, error) {
+				return newArticleQueryBuilder(uuid.Nil).withTrashed().page(&limit, &offset), nil
+			},
+			wantInQuery:  []string{"a.deleted_at IS NOT NULL", "ORDER BY a.deleted_at DESC, a.id DESC", "OFFSET @offset"},
+			wantNotQuery: []string{"a.deleted_at IS NULL"},
+			wantInCount:  []string{"a.deleted_at IS NOT NULL"},
+		},
+		{
+			name: "drafts are the unpublished articles of the user",
+			builder: func() (*articleQueryBuilder, error) {
+				return newArticleQueryBuilder(uuid.Nil).withDrafts().page(&limit, &offset), nil
+			},
+			wantInQuery: []string{
+				"a.published_at IS NULL AND a.author_id = @userID",
+				"ORDER BY a.updated_at DESC, a.id DESC",
+			},
+			wantInCount: []string{"a.published_at IS NULL AND a.author_id = @userID"},
+			wantArgs:    []string{"userID", "limit", "offset"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder, err := tt.builder()
+			if err != nil {
+				t.Fatalf("articleQueryBuilder error = %v", err)
+			}
+
+			query, args := builder.selectQuery()
+			countQuery, _ := builder.countQuery()
+
+			for _, want := range tt.wantInQuery {
+				if !strings.Contains(query, want) {
+					t.Errorf("articleQueryBuilder.selectQuery() = %v, want %q", query, want)
+				}
+			}
+
+			for _, notWant := range tt.wantNotQuery {
+				if strings.Contains(query, notWant) {
+					t.Errorf("articleQueryBuilder.selectQuery() = %v, do not want %q", query, notWant)
+				}
+			}
+
+			for _, want := range tt.wantInCount {
+				if !strings.Contains(countQuery, want) {
+					t.Errorf("articleQueryBuilder.countQuery() = %v, want %q", countQuery, want)
+				}
+			}
+
+			if strings.Contains(countQuery, "@cursor") {
+				t.Errorf("articleQueryBuilder.countQuery() = %v, should not page", countQuery)
+			}
+
+			for _, want := range tt.wantArgs {
+				if _, ok := args[want]; !ok {
+					t.Errorf("articleQueryBuilder.selectQuery() args = %v, want %v", args, want)
+				}
+			}
+		})
+	}
+}
+
+func TestArticleQueryBuilder_AfterCountSort(t *testing.T) {
+	t.Parallel()
+
+	builder := newArticleQueryBuilder(uuid.Nil).
+		withFilter(domain.ArticleFilter{Sort: domain.ArticleSortMostFavorited})
+
+	if err := builder.after(&domain.Cursor{ID: uuid.NewString()}); err == nil {
+		t.Errorf("articleQueryBuilder.after() should reject a cursor when sorting by counts")
+	}
+}
+
+func TestHighlightHTML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "matches are marked",
+			text: "how to train your " + highlightStart + "dragon" + highlightStop,
+			want: "how to train your <mark>dragon</mark>",
+		},
+		{
+			name: "html of the body is escaped",
+			text: "<script>" + highlightStart + "dragon" + highlightStop + "</script>",
+			want: "&lt;script&gt;<mark>dragon</mark>&lt;/script&gt;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := highlightHTML(tt.text); got != tt.want {
+				t.Errorf("highlightHTML() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
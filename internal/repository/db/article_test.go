@@ -1,7 +1,11 @@
 package db
 
 import (
+	"errors"
+	"reflect"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -77,10 +81,14 @@ func TestRepository_CreateArticle(t *testing.T) {
 			got, err := testrep.CreateArticle(
 				t.Context(),
 				usr.ID,
+				domain.GetSlugFromTitle(tt.args.title),
 				tt.args.title,
 				tt.args.description,
 				tt.args.body,
 				tt.args.tagList,
+				domain.ArticleStatusPublished,
+				nil,
+				domain.ArticleMetadata{},
 			)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Repository.CreateArticle() error = %v, wantErr %v", err, tt.wantErr)
@@ -167,10 +175,14 @@ func TestRepository_GetArticle(t *testing.T) {
 			_, _ = testrep.CreateArticle(
 				t.Context(),
 				usr.ID,
+				domain.GetSlugFromTitle(tt.want.Title),
 				tt.want.Title,
 				tt.want.Description,
 				tt.want.Body,
 				tags,
+				domain.ArticleStatusPublished,
+				nil,
+				domain.ArticleMetadata{},
 			)
 
 			got, err := testrep.GetArticle(t.Context(), usr.ID, tt.slug)
@@ -195,3 +207,888 @@ func TestRepository_GetArticle(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_GetArticles(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "get_articles")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	userID, errU := uuid.NewV7()
+	if errU != nil {
+		t.Fatalf("could not generate uuid: %v", errU)
+	}
+
+	usr, errUsr := testrep.RegisterUser(t.Context(), userID, "lister", "lister@gmail.com", "")
+	if errUsr != nil {
+		t.Fatalf("could not register user: %v", errUsr)
+	}
+
+	titles := []string{"First dragon", "Second dragon", "Third dragon"}
+	for _, title := range titles {
+		if _, err := testrep.CreateArticle(
+			t.Context(),
+			usr.ID,
+			domain.GetSlugFromTitle(title),
+			title,
+			"Ever wonder how?",
+			"It takes a Jacobian",
+			[]string{"listing"},
+			domain.ArticleStatusPublished,
+			nil,
+			domain.ArticleMetadata{},
+		); err != nil {
+			t.Fatalf("could not create article: %v", err)
+		}
+	}
+
+	filter := domain.ArticleFilter{Authors: []string{"lister"}}
+	limit := 2
+
+	tests := []struct {
+		name      string
+		offset    int
+		afterSlug string
+		wantSlugs []string
+	}{
+		{
+			name:      "first page",
+			offset:    0,
+			wantSlugs: []string{"third-dragon", "second-dragon"},
+		},
+		{
+			name:      "last page",
+			offset:    2, //nolint:mnd // second page
+			wantSlugs: []string{"first-dragon"},
+		},
+		{
+			name:      "page after cursor ignores offset",
+			offset:    2, //nolint:mnd // ignored
+			afterSlug: "third-dragon",
+			wantSlugs: []string{"second-dragon", "first-dragon"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var after *domain.Cursor
+
+			if tt.afterSlug != "" {
+				art, errA := testrep.GetArticle(t.Context(), usr.ID, tt.afterSlug)
+				if errA != nil {
+					t.Fatalf("could not get article: %v", errA)
+				}
+
+				cursor := art.Cursor()
+				after = &cursor
+			}
+
+			got, err := testrep.GetArticles(
+				t.Context(),
+				usr.ID,
+				filter,
+				&limit,
+				&tt.offset,
+				after,
+			)
+			if err != nil {
+				t.Fatalf("Repository.GetArticles() error = %v", err)
+			}
+
+			if got.Total != len(titles) {
+				t.Errorf("Repository.GetArticles() total = %v, want %v", got.Total, len(titles))
+			}
+
+			if len(got.Articles) != len(tt.wantSlugs) {
+				t.Fatalf("Repository.GetArticles() = %v articles, want %v", len(got.Articles), len(tt.wantSlugs))
+			}
+
+			for idx, slug := range tt.wantSlugs {
+				if got.Articles[idx].Slug != slug {
+					t.Errorf("Repository.GetArticles()[%d] = %v, want %v", idx, got.Articles[idx].Slug, slug)
+				}
+			}
+		})
+	}
+}
+
+func TestRepository_GetArticles_PublicationOrder(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "get_articles_publication_order")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "slowpoke", "slowpoke@gmail.com", "")
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	// the draft is written first, and published after the other article
+	for _, art := range []struct {
+		slug   string
+		status domain.ArticleStatus
+	}{
+		{slug: "early-draft", status: domain.ArticleStatusDraft},
+		{slug: "quick-post", status: domain.ArticleStatusPublished},
+	} {
+		if _, err := testrep.CreateArticle(
+			t.Context(), usr.ID, art.slug, art.slug, "d", "b", nil, art.status, nil, domain.ArticleMetadata{},
+		); err != nil {
+			t.Fatalf("could not create article: %v", err)
+		}
+	}
+
+	published, errP := testrep.PublishArticle(t.Context(), usr.ID, "early-draft", nil)
+	if errP != nil {
+		t.Fatalf("Repository.PublishArticle() error = %v", errP)
+	}
+
+	limit := 1
+
+	first, errF := testrep.GetArticles(t.Context(), usr.ID, domain.ArticleFilter{}, &limit, nil, nil)
+	if errF != nil || len(first.Articles) != 1 || first.Articles[0].Slug != "early-draft" {
+		t.Fatalf("Repository.GetArticles() = %v, %v, want the latest published first", first, errF)
+	}
+
+	cursor := published.Cursor()
+
+	next, errN := testrep.GetArticles(t.Context(), usr.ID, domain.ArticleFilter{}, &limit, nil, &cursor)
+	if errN != nil || len(next.Articles) != 1 || next.Articles[0].Slug != "quick-post" {
+		t.Errorf("Repository.GetArticles() after the cursor = %v, %v, want the earlier publication", next, errN)
+	}
+}
+
+func TestRepository_GetArticles_Filter(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "get_articles_filter")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	author, errA := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"filterauthor",
+		"filterauthor@gmail.com",
+		"",
+	)
+	if errA != nil {
+		t.Fatalf("could not register user: %v", errA)
+	}
+
+	bob, errB := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "filterbob", "filterbob@gmail.com", "")
+	if errB != nil {
+		t.Fatalf("could not register user: %v", errB)
+	}
+
+	articles := []struct {
+		title string
+		tags  []string
+	}{
+		{title: "Filter dragons", tags: []string{"filter-dragons"}},
+		{title: "Filter dragons training", tags: []string{"filter-dragons", "filter-training"}},
+		{title: "Filter training", tags: []string{"filter-training"}},
+	}
+
+	for _, art := range articles {
+		if _, err := testrep.CreateArticle(
+			t.Context(),
+			author.ID,
+			domain.GetSlugFromTitle(art.title),
+			art.title,
+			"Ever wonder how?",
+			"It takes a Jacobian",
+			art.tags,
+			domain.ArticleStatusPublished,
+			nil,
+			domain.ArticleMetadata{},
+		); err != nil {
+			t.Fatalf("could not create article: %v", err)
+		}
+	}
+
+	// bob only favorites one article
+	if _, err := testrep.FavoriteArticle(t.Context(), bob.ID, "filter-dragons"); err != nil {
+		t.Fatalf("could not favorite article: %v", err)
+	}
+
+	bobUsername := bob.Username
+
+	tests := []struct {
+		name      string
+		filter    domain.ArticleFilter
+		wantSlugs []string
+	}{
+		{
+			name:      "favorited by bob",
+			filter:    domain.ArticleFilter{Favorited: &bobUsername},
+			wantSlugs: []string{"filter-dragons"},
+		},
+		{
+			name: "any tag",
+			filter: domain.ArticleFilter{
+				Tags: []string{"filter-dragons", "filter-training"},
+			},
+			wantSlugs: []string{"filter-training", "filter-dragons-training", "filter-dragons"},
+		},
+		{
+			name: "all tags",
+			filter: domain.ArticleFilter{
+				Tags:     []string{"filter-dragons", "filter-training"},
+				TagMatch: domain.TagMatchAll,
+			},
+			wantSlugs: []string{"filter-dragons-training"},
+		},
+		{
+			name: "excluded tag, oldest first",
+			filter: domain.ArticleFilter{
+				Authors:      []string{"filterauthor"},
+				ExcludedTags: []string{"filter-training"},
+				Sort:         domain.ArticleSortOldest,
+			},
+			wantSlugs: []string{"filter-dragons"},
+		},
+		{
+			name: "most favorited",
+			filter: domain.ArticleFilter{
+				Authors: []string{"filterauthor"},
+				Sort:    domain.ArticleSortMostFavorited,
+			},
+			wantSlugs: []string{"filter-dragons", "filter-training", "filter-dragons-training"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testrep.GetArticles(t.Context(), bob.ID, tt.filter, nil, nil, nil)
+			if err != nil {
+				t.Fatalf("Repository.GetArticles() error = %v", err)
+			}
+
+			if got.Total != len(tt.wantSlugs) || len(got.Articles) != len(tt.wantSlugs) {
+				t.Fatalf("Repository.GetArticles() = %v articles of %v, want %v", len(got.Articles), got.Total, tt.wantSlugs)
+			}
+
+			for idx, slug := range tt.wantSlugs {
+				if got.Articles[idx].Slug != slug {
+					t.Errorf("Repository.GetArticles()[%d] = %v, want %v", idx, got.Articles[idx].Slug, slug)
+				}
+			}
+		})
+	}
+}
+
+func TestRepository_SearchArticles(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "search_articles")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errUsr := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"searcher",
+		"searcher@gmail.com",
+		"",
+	)
+	if errUsr != nil {
+		t.Fatalf("could not register user: %v", errUsr)
+	}
+
+	articles := []struct {
+		title, description, body string
+	}{
+		{"Training dragons", "A guide", "Feed them well."},
+		{"Cooking", "About dragons", "Slow <b>fire</b>."},
+		{"Gardening", "Plants", "Nothing to see."},
+	}
+
+	for _, art := range articles {
+		if _, err := testrep.CreateArticle(
+			t.Context(),
+			usr.ID,
+			domain.GetSlugFromTitle(art.title),
+			art.title,
+			art.description,
+			art.body,
+			[]string{"search"},
+			domain.ArticleStatusPublished,
+			nil,
+			domain.ArticleMetadata{},
+		); err != nil {
+			t.Fatalf("could not create article: %v", err)
+		}
+	}
+
+	got, err := testrep.SearchArticles(t.Context(), usr.ID, "dragon", nil, nil)
+	if err != nil {
+		t.Fatalf("Repository.SearchArticles() error = %v", err)
+	}
+
+	// the title weighs more than the description
+	if got.Total != 2 || len(got.Results) != 2 ||
+		got.Results[0].Slug != "training-dragons" || got.Results[1].Slug != "cooking" {
+		t.Fatalf("Repository.SearchArticles() = %+v", got)
+	}
+
+	if got.Results[0].TitleHighlight != "Training <mark>dragons</mark>" {
+		t.Errorf("Repository.SearchArticles() title highlight = %v", got.Results[0].TitleHighlight)
+	}
+
+	if got.Results[0].Rank <= got.Results[1].Rank {
+		t.Errorf("Repository.SearchArticles() ranks = %v, %v", got.Results[0].Rank, got.Results[1].Rank)
+	}
+}
+
+func TestRepository_ArticleSlugs(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "article_slugs")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errUsr := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "slugger", "slugger@gmail.com", "")
+	if errUsr != nil {
+		t.Fatalf("could not register user: %v", errUsr)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), usr.ID, "hello", "Hello", "d", "b", nil, domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create article: %v", err)
+	}
+
+	// the same slug is taken
+	_, errDup := testrep.CreateArticle(
+		t.Context(), usr.ID, "hello", "Hello", "d", "b", nil, domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	)
+	if !errors.Is(errDup, domain.ErrSlugTaken) {
+		t.Fatalf("Repository.CreateArticle() error = %v, want %v", errDup, domain.ErrSlugTaken)
+	}
+
+	// rename, the former slug stays reserved and points to the new one
+	title := "World"
+	newSlug := "world"
+
+	renamed, errU := testrep.UpdateArticle(t.Context(), usr.ID, "hello", &newSlug, &title, nil, nil, nil, nil, nil)
+	if errU != nil {
+		t.Fatalf("Repository.UpdateArticle() error = %v", errU)
+	}
+
+	if renamed.Slug != newSlug || renamed.Title != title {
+		t.Errorf("Repository.UpdateArticle() = %v, want slug %v", renamed, newSlug)
+	}
+
+	currentSlug, errS := testrep.GetArticleCurrentSlug(t.Context(), usr.ID, "hello")
+	if errS != nil || currentSlug != newSlug {
+		t.Errorf("Repository.GetArticleCurrentSlug() = %v, %v, want %v", currentSlug, errS, newSlug)
+	}
+
+	_, errFormer := testrep.CreateArticle(
+		t.Context(), usr.ID, "hello", "Hello", "d", "b", nil, domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	)
+	if !errors.Is(errFormer, domain.ErrSlugTaken) {
+		t.Errorf("Repository.CreateArticle() on a former slug error = %v, want %v", errFormer, domain.ErrSlugTaken)
+	}
+
+	// renaming back to a former slug of the same article is allowed
+	title = "Hello"
+	oldSlug := "hello"
+
+	if _, err := testrep.UpdateArticle(
+		t.Context(), usr.ID, newSlug, &oldSlug, &title, nil, nil, nil, nil, nil,
+	); err != nil {
+		t.Errorf("Repository.UpdateArticle() back error = %v", err)
+	}
+
+	// the former slug of a draft only leads its author to the new one
+	reader, errR := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "peeker", "peeker@gmail.com", "")
+	if errR != nil {
+		t.Fatalf("could not register reader: %v", errR)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), usr.ID, "secret", "Secret", "d", "b", nil, domain.ArticleStatusDraft, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create draft: %v", err)
+	}
+
+	title = "Revealed"
+	draftSlug := "revealed"
+
+	if _, err := testrep.UpdateArticle(
+		t.Context(), usr.ID, "secret", &draftSlug, &title, nil, nil, nil, nil, nil,
+	); err != nil {
+		t.Fatalf("Repository.UpdateArticle() of the draft error = %v", err)
+	}
+
+	if _, err := testrep.GetArticleCurrentSlug(t.Context(), reader.ID, "secret"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.GetArticleCurrentSlug() of a draft by a reader error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	currentSlug, errS = testrep.GetArticleCurrentSlug(t.Context(), usr.ID, "secret")
+	if errS != nil || currentSlug != draftSlug {
+		t.Errorf("Repository.GetArticleCurrentSlug() of a draft = %v, %v, want %v", currentSlug, errS, draftSlug)
+	}
+}
+
+func TestRepository_UpdateArticle_Tags(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "update_article_tags")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errUsr := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "tagger", "tagger@gmail.com", "")
+	if errUsr != nil {
+		t.Fatalf("could not register user: %v", errUsr)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), usr.ID, "tagged", "Tagged", "d", "b", []string{"golang", "pgx"},
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create article: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		tagList *[]string
+		want    []domain.Tag
+	}{
+		{
+			name: "tags kept when omitted",
+			want: []domain.Tag{"golang", "pgx"},
+		},
+		{
+			name:    "typo fixed and tag added",
+			tagList: &[]string{"go", "pgx", "chi", "go"},
+			want:    []domain.Tag{"chi", "go", "pgx"},
+		},
+		{
+			name:    "tags removed",
+			tagList: &[]string{},
+			want:    nil,
+		},
+	}
+
+	// the cases update the same article, in order
+	for _, tt := range tests {
+		body := tt.name
+
+		got, err := testrep.UpdateArticle(t.Context(), usr.ID, "tagged", nil, nil, nil, &body, tt.tagList, nil, nil)
+		if err != nil {
+			t.Fatalf("%s: Repository.UpdateArticle() error = %v", tt.name, err)
+		}
+
+		if got.Body != body {
+			t.Errorf("%s: Repository.UpdateArticle() body = %v, want %v", tt.name, got.Body, body)
+		}
+
+		if !slices.Equal(got.TagList, tt.want) {
+			t.Errorf("%s: Repository.UpdateArticle() tags = %v, want %v", tt.name, got.TagList, tt.want)
+		}
+	}
+}
+
+func TestRepository_UpdateArticle_Version(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "update_article_version")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errUsr := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "versioner", "versioner@gmail.com", "")
+	if errUsr != nil {
+		t.Fatalf("could not register user: %v", errUsr)
+	}
+
+	created, errC := testrep.CreateArticle(
+		t.Context(), usr.ID, "versioned", "Versioned", "d", "b", nil,
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	)
+	if errC != nil {
+		t.Fatalf("could not create article: %v", errC)
+	}
+
+	if created.Version != 1 {
+		t.Fatalf("Repository.CreateArticle() version = %v, want 1", created.Version)
+	}
+
+	tests := []struct {
+		name             string
+		expectedVersions []int
+		wantErr          error
+		wantVersion      int
+	}{
+		{
+			name:             "current version",
+			expectedVersions: []int{1},
+			wantVersion:      2,
+		},
+		{
+			name:             "stale version",
+			expectedVersions: []int{1},
+			wantErr:          domain.ErrVersionMismatch,
+		},
+		{
+			name:             "one of the versions",
+			expectedVersions: []int{1, 2},
+			wantVersion:      3,
+		},
+		{
+			name:        "unconditional",
+			wantVersion: 4,
+		},
+	}
+
+	// the cases update the same article, in order
+	for _, tt := range tests {
+		body := tt.name
+
+		got, err := testrep.UpdateArticle(
+			t.Context(), usr.ID, "versioned", nil, nil, nil, &body, nil, nil, tt.expectedVersions,
+		)
+		if !errors.Is(err, tt.wantErr) {
+			t.Fatalf("%s: Repository.UpdateArticle() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+
+		if tt.wantErr != nil {
+			continue
+		}
+
+		if got.Version != tt.wantVersion {
+			t.Errorf("%s: Repository.UpdateArticle() version = %v, want %v", tt.name, got.Version, tt.wantVersion)
+		}
+	}
+
+	published, err := testrep.UnpublishArticle(t.Context(), usr.ID, "versioned")
+	if err != nil {
+		t.Fatalf("Repository.UnpublishArticle() error = %v", err)
+	}
+
+	if published.Version != 5 {
+		t.Errorf("Repository.UnpublishArticle() version = %v, want 5", published.Version)
+	}
+}
+
+func TestRepository_ArticleMetadata(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "article_metadata")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errUsr := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "metadata", "metadata@gmail.com", "")
+	if errUsr != nil {
+		t.Fatalf("could not register user: %v", errUsr)
+	}
+
+	metadata := domain.ArticleMetadata{
+		WordCount:       3,
+		ReadingTime:     1,
+		TableOfContents: []domain.TOCEntry{{Level: 1, Text: "Intro", Anchor: "intro"}},
+	}
+
+	created, errC := testrep.CreateArticle(
+		t.Context(), usr.ID, "measured", "Measured", "d", "# Intro\n\nhello", nil,
+		domain.ArticleStatusPublished, nil, metadata,
+	)
+	if errC != nil {
+		t.Fatalf("Repository.CreateArticle() error = %v", errC)
+	}
+
+	if !reflect.DeepEqual(created.ArticleMetadata, metadata) {
+		t.Errorf("Repository.CreateArticle() metadata = %+v, want %+v", created.ArticleMetadata, metadata)
+	}
+
+	// the metadata is kept when the body is not updated
+	title := "Measured"
+
+	kept, errK := testrep.UpdateArticle(t.Context(), usr.ID, "measured", nil, &title, nil, nil, nil, nil, nil)
+	if errK != nil {
+		t.Fatalf("Repository.UpdateArticle() error = %v", errK)
+	}
+
+	if !reflect.DeepEqual(kept.ArticleMetadata, metadata) {
+		t.Errorf("Repository.UpdateArticle() metadata = %+v, want %+v", kept.ArticleMetadata, metadata)
+	}
+
+	body := "bye"
+	updatedMetadata := domain.ArticleMetadata{WordCount: 1, ReadingTime: 1, TableOfContents: []domain.TOCEntry{}}
+
+	updated, errU := testrep.UpdateArticle(
+		t.Context(), usr.ID, "measured", nil, nil, nil, &body, nil, &updatedMetadata, nil,
+	)
+	if errU != nil {
+		t.Fatalf("Repository.UpdateArticle() error = %v", errU)
+	}
+
+	if !reflect.DeepEqual(updated.ArticleMetadata, updatedMetadata) {
+		t.Errorf("Repository.UpdateArticle() metadata = %+v, want %+v", updated.ArticleMetadata, updatedMetadata)
+	}
+}
+
+func TestRepository_ArticlePublication(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "article_publication")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	author, errA := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "writer", "writer@gmail.com", "")
+	if errA != nil {
+		t.Fatalf("could not register author: %v", errA)
+	}
+
+	reader, errR := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "reader", "reader@gmail.com", "")
+	if errR != nil {
+		t.Fatalf("could not register reader: %v", errR)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), author.ID, "live", "Live", "d", "b", []string{"public"},
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create article: %v", err)
+	}
+
+	draft, errD := testrep.CreateArticle(
+		t.Context(), author.ID, "draft", "Draft", "d", "b", []string{"secret"},
+		domain.ArticleStatusDraft, nil, domain.ArticleMetadata{},
+	)
+	if errD != nil {
+		t.Fatalf("could not create draft: %v", errD)
+	}
+
+	if draft.Status != domain.ArticleStatusDraft {
+		t.Errorf("Repository.CreateArticle() status = %v, want %v", draft.Status, domain.ArticleStatusDraft)
+	}
+
+	// the draft is only visible to its author
+	if _, err := testrep.GetArticle(t.Context(), reader.ID, "draft"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.GetArticle() by a reader error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	if _, err := testrep.GetArticle(t.Context(), author.ID, "draft"); err != nil {
+		t.Errorf("Repository.GetArticle() by the author error = %v", err)
+	}
+
+	page, errP := testrep.GetArticles(t.Context(), author.ID, domain.ArticleFilter{}, nil, nil, nil)
+	if errP != nil || page.Total != 1 {
+		t.Errorf("Repository.GetArticles() = %v, %v, want the published article only", page, errP)
+	}
+
+	drafts, errDr := testrep.GetDraftArticles(t.Context(), author.ID, nil, nil)
+	if errDr != nil || drafts.Total != 1 || drafts.Articles[0].Slug != "draft" {
+		t.Errorf("Repository.GetDraftArticles() = %v, %v, want the draft only", drafts, errDr)
+	}
+
+	drafts, errDr = testrep.GetDraftArticles(t.Context(), reader.ID, nil, nil)
+	if errDr != nil || drafts.Total != 0 {
+		t.Errorf("Repository.GetDraftArticles() of the reader = %v, %v, want no draft", drafts, errDr)
+	}
+
+	tags, errT := testrep.GetTags(t.Context())
+	if errT != nil || !slices.Equal(tags, []domain.Tag{"public"}) {
+		t.Errorf("Repository.GetTags() = %v, %v, want [public]", tags, errT)
+	}
+
+	if _, err := testrep.AddComment(t.Context(), reader.ID, "draft", "first", nil); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.AddComment() on a draft error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	if _, err := testrep.FavoriteArticle(t.Context(), reader.ID, "draft"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.FavoriteArticle() on a draft error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	// publish, then unpublish
+	published, errPub := testrep.PublishArticle(t.Context(), author.ID, "draft", nil)
+	if errPub != nil || published.Status != domain.ArticleStatusPublished {
+		t.Fatalf("Repository.PublishArticle() = %v, %v, want published", published, errPub)
+	}
+
+	if _, err := testrep.GetArticle(t.Context(), reader.ID, "draft"); err != nil {
+		t.Errorf("Repository.GetArticle() of a published article error = %v", err)
+	}
+
+	// scheduling a published article would unpublish it
+	later := time.Now().Add(time.Hour)
+	if _, err := testrep.PublishArticle(t.Context(), author.ID, "draft", &later); !errors.Is(
+		err, domain.ErrArticlePublished,
+	) {
+		t.Errorf("Repository.PublishArticle() of a published article error = %v, want %v", err, domain.ErrArticlePublished)
+	}
+
+	if got, err := testrep.GetArticle(t.Context(), reader.ID, "draft"); err != nil ||
+		got.Status != domain.ArticleStatusPublished || got.PublishAt != nil {
+		t.Errorf("Repository.GetArticle() after scheduling = %v, %v, want still published", got, err)
+	}
+
+	unpublished, errUnpub := testrep.UnpublishArticle(t.Context(), author.ID, "draft")
+	if errUnpub != nil || unpublished.Status != domain.ArticleStatusDraft {
+		t.Errorf("Repository.UnpublishArticle() = %v, %v, want a draft", unpublished, errUnpub)
+	}
+
+	// a publication time already past is published on the next run of the scheduler
+	publishAt := time.Now().Add(-time.Minute)
+
+	scheduled, errS := testrep.PublishArticle(t.Context(), author.ID, "draft", &publishAt)
+	if errS != nil || scheduled.Status != domain.ArticleStatusScheduled {
+		t.Fatalf("Repository.PublishArticle() = %v, %v, want scheduled", scheduled, errS)
+	}
+
+	count, errSch := testrep.PublishScheduledArticles(t.Context())
+	if errSch != nil || count != 1 {
+		t.Errorf("Repository.PublishScheduledArticles() = %v, %v, want 1", count, errSch)
+	}
+
+	got, errG := testrep.GetArticle(t.Context(), reader.ID, "draft")
+	if errG != nil || got.Status != domain.ArticleStatusPublished || got.PublishAt != nil {
+		t.Errorf("Repository.GetArticle() after the scheduler = %v, %v, want published", got, errG)
+	}
+}
+
+func TestRepository_ArticleTrash(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "article_trash")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	author, errA := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "trasher", "trasher@gmail.com", "")
+	if errA != nil {
+		t.Fatalf("could not register author: %v", errA)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), author.ID, "mistake", "Mistake", "d", "b", []string{"oops"},
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create article: %v", err)
+	}
+
+	if _, err := testrep.AddComment(t.Context(), author.ID, "mistake", "kept", nil); err != nil {
+		t.Fatalf("could not add comment: %v", err)
+	}
+
+	if err := testrep.DeleteArticle(t.Context(), author.ID, "mistake"); err != nil {
+		t.Fatalf("Repository.DeleteArticle() error = %v", err)
+	}
+
+	// the trashed article is hidden from every read path
+	if _, err := testrep.GetArticle(t.Context(), author.ID, "mistake"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.GetArticle() of a trashed article error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	page, errP := testrep.GetArticles(t.Context(), author.ID, domain.ArticleFilter{}, nil, nil, nil)
+	if errP != nil || page.Total != 0 {
+		t.Errorf("Repository.GetArticles() = %v, %v, want no article", page, errP)
+	}
+
+	comments, errC := testrep.GetComments(t.Context(), author.ID, "mistake", "", nil, nil, nil)
+	if errC != nil || len(comments) != 0 {
+		t.Errorf("Repository.GetComments() = %v, %v, want no comment", comments, errC)
+	}
+
+	tags, errT := testrep.GetTags(t.Context())
+	if errT != nil || len(tags) != 0 {
+		t.Errorf("Repository.GetTags() = %v, %v, want no tag", tags, errT)
+	}
+
+	trash, errTr := testrep.GetTrashedArticles(t.Context(), author.ID, nil, nil)
+	if errTr != nil || trash.Total != 1 || trash.Articles[0].DeletedAt == nil {
+		t.Fatalf("Repository.GetTrashedArticles() = %v, %v, want the trashed article", trash, errTr)
+	}
+
+	// restoring brings back the comments
+	restored, errR := testrep.RestoreArticle(t.Context(), author.ID, "mistake")
+	if errR != nil || restored.DeletedAt != nil {
+		t.Fatalf("Repository.RestoreArticle() = %v, %v, want the restored article", restored, errR)
+	}
+
+	comments, errC = testrep.GetComments(t.Context(), author.ID, "mistake", "", nil, nil, nil)
+	if errC != nil || len(comments) != 1 {
+		t.Errorf("Repository.GetComments() after restore = %v, %v, want 1 comment", comments, errC)
+	}
+
+	if _, err := testrep.RestoreArticle(t.Context(), author.ID, "mistake"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.RestoreArticle() of a live article error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	// the purge only deletes the articles trashed for longer than the retention
+	if err := testrep.DeleteArticle(t.Context(), author.ID, "mistake"); err != nil {
+		t.Fatalf("Repository.DeleteArticle() error = %v", err)
+	}
+
+	count, errPu := testrep.PurgeTrashedArticles(t.Context(), time.Hour)
+	if errPu != nil || count != 0 {
+		t.Errorf("Repository.PurgeTrashedArticles() = %v, %v, want 0", count, errPu)
+	}
+
+	count, errPu = testrep.PurgeTrashedArticles(t.Context(), 0)
+	if errPu != nil || count != 1 {
+		t.Errorf("Repository.PurgeTrashedArticles() = %v, %v, want 1", count, errPu)
+	}
+
+	if _, err := testrep.RestoreArticle(t.Context(), author.ID, "mistake"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.RestoreArticle() of a purged article error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
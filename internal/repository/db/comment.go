@@ -2,7 +2,9 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/induzo/gocom/database/pginit/v2"
@@ -11,53 +13,134 @@ import (
 	"realworld/internal/domain"
 )
 
+// commentJSON builds the comment c, a deleted comment only keeps its place in the thread.
+// c.edited is whether the comment has revisions.
+const commentJSON = `
+	JSON_BUILD_OBJECT(
+		'id', c.id,
+		'parent_id', c.parent_id,
+		'depth', c.depth,
+		'body', CASE WHEN c.deleted_at IS NULL THEN c.body ELSE @deletedBody END,
+		'deleted', c.deleted_at IS NOT NULL,
+		'edited', c.edited,
+		'created_at', c.created_at,
+		'updated_at', c.updated_at,
+		'author', CASE WHEN c.deleted_at IS NULL THEN (
+			SELECT JSON_BUILD_OBJECT(
+				'username', u.username,
+				'bio', u.bio,
+				'img', u.img,` + profileCountsJSON + `
+				'following', EXISTS(
+					SELECT 1
+					FROM appuser_follows
+					WHERE follower_id = @userID
+					AND followee_id = u.id
+				)
+			)
+			FROM appuser u
+			WHERE u.id = c.author_id
+		) END
+	)`
+
+// commentThreadOrder orders the comments of the article c by the sort.
+func commentThreadOrder(sort domain.CommentSort) string {
+	switch sort {
+	case domain.CommentSortNewest:
+		return "c.created_at DESC, c.id DESC"
+	case domain.CommentSortTop:
+		return "(SELECT COUNT(*) FROM comment r WHERE r.parent_id = c.id) DESC, c.created_at, c.id"
+	case domain.CommentSortOldest:
+		return "c.created_at, c.id"
+	default:
+		return "c.created_at, c.id"
+	}
+}
+
+// GetComments returns the threads of comments of the article in the order of the sort,
+// each comment followed by its replies. The pagination applies to the comments of the article.
 func (r *Repository) GetComments(
 	ctx context.Context,
 	userID uuid.UUID,
 	slug string,
+	sort domain.CommentSort,
+	limit, offset *int,
+	after *domain.Cursor,
 ) ([]*domain.Comment, error) {
-	// query with named args
-	query := `
+	order := commentThreadOrder(sort)
+
+	// the comments of the article of the page, the path of a thread starts with its rank
+	threads := `
 		SELECT
-			JSON_BUILD_OBJECT(
-				'id', c.id,
-				'body', c.body,
-				'created_at', c.created_at,
-				'updated_at', c.updated_at,
-				'author', (
-					SELECT JSON_BUILD_OBJECT(
-						'username', u.username,
-						'bio', u.bio,
-						'img', u.img,
-						'following', EXISTS(
-							SELECT 1
-							FROM appuser_follows
-							WHERE follower_id = @userID
-							AND followee_id = u.id
-						)
-					)
-					FROM appuser u
-					WHERE u.id = c.author_id
-				)
-			)
+			c.id, c.parent_id, c.depth, c.body, c.author_id, c.created_at, c.updated_at, c.deleted_at,
+			EXISTS(SELECT 1 FROM comment_revision cr WHERE cr.comment_id = c.id) AS edited,
+			ARRAY[(ROW_NUMBER() OVER (ORDER BY ` + order + `))::numeric] AS path
 		FROM comment c
 		JOIN article a ON c.article_id = a.id AND a.slug = @slug
-	`
+		AND (a.published_at IS NOT NULL OR a.author_id = @userID)
+		AND a.deleted_at IS NULL
+		WHERE c.parent_id IS NULL`
 
 	// named parameters
 	args := pgx.NamedArgs{
-		"slug":   slug,
-		"userID": userID,
+		"slug":        slug,
+		"userID":      userID,
+		"deletedBody": domain.DeletedCommentBody,
 	}
 
-	rows, errR := r.pool.Query(ctx, query, args)
+	// keyset pagination, in the direction of the sort
+	if after != nil {
+		cursorID, errC := strconv.Atoi(after.ID)
+		if errC != nil {
+			return nil, fmt.Errorf("could not parse cursor: %w", domain.ErrInvalidCursor)
+		}
+
+		if sort == domain.CommentSortNewest {
+			threads += "\nAND (c.created_at, c.id) < (@cursorCreatedAt, @cursorID)"
+		} else {
+			threads += "\nAND (c.created_at, c.id) > (@cursorCreatedAt, @cursorID)"
+		}
+
+		args["cursorCreatedAt"] = after.CreatedAt
+		args["cursorID"] = cursorID
+	}
+
+	threads += "\nORDER BY " + order
+
+	if limit != nil {
+		threads += " LIMIT @limit"
+		args["limit"] = limit
+	}
+
+	// as in the article listings, the offset is ignored with a cursor
+	if offset != nil && after == nil {
+		threads += " OFFSET @offset"
+		args["offset"] = offset
+	}
+
+	// the replies, sorted by their path of creation times from the comment of the article
+	query := `
+		WITH RECURSIVE thread AS (
+			(` + threads + `)
+			UNION ALL
+			SELECT
+				c.id, c.parent_id, c.depth, c.body, c.author_id, c.created_at, c.updated_at, c.deleted_at,
+				EXISTS(SELECT 1 FROM comment_revision cr WHERE cr.comment_id = c.id),
+				t.path || ARRAY[EXTRACT(EPOCH FROM c.created_at), c.id]
+			FROM comment c
+			JOIN thread t ON c.parent_id = t.id
+		)
+		SELECT ` + commentJSON + `
+		FROM thread c
+		ORDER BY c.path`
+
+	rows, errR := r.conn.Query(ctx, query, args)
 	if errR != nil {
-		return nil, fmt.Errorf("could not get profile: %w", errR)
+		return nil, fmt.Errorf("could not get comments: %w", domainError(errR))
 	}
 
 	comments, errA := pgx.CollectRows(rows, pginit.JSONRowToAddrOfStruct[domain.Comment])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect row: %w", errA)
+		return nil, fmt.Errorf("could not collect row: %w", domainError(errA))
 	}
 
 	return comments, nil
@@ -67,80 +150,238 @@ func (r *Repository) AddComment(
 	ctx context.Context,
 	authorID uuid.UUID,
 	slug, body string,
+	parentID *int,
 ) (*domain.Comment, error) {
-	// query with named args
+	// query with named args, a reply is one level deeper than its parent,
+	// which must be a comment of the same article that is not deleted
 	query := `
-		INSERT INTO comment (body, author_id, article_id)
-		VALUES (
-			@body,
-			@authorID,
-			(SELECT id FROM article WHERE slug = @slug)
-		)
-		RETURNING
-			JSON_BUILD_OBJECT(
-				'id', id,
-				'body', body,
-				'created_at', created_at,
-				'updated_at', updated_at,
-				'author', (
-					SELECT JSON_BUILD_OBJECT(
-						'username', u.username,
-						'bio', u.bio,
-						'img', u.img,
-						'following', EXISTS(
-							SELECT 1
-							FROM appuser_follows
-							WHERE follower_id = @userID
-							AND followee_id = u.id
-						)
-					)
-					FROM appuser u
-					WHERE u.id = author_id
-				)
+		WITH art AS (
+			SELECT id FROM article WHERE slug = @slug AND published_at IS NOT NULL AND deleted_at IS NULL
+		), c AS (
+			INSERT INTO comment (body, author_id, article_id, parent_id, depth)
+			VALUES (
+				@body,
+				@authorID,
+				(SELECT id FROM art),
+				@parentID,
+				CASE WHEN @parentID::int IS NULL THEN 0 ELSE (
+					SELECT p.depth + 1
+					FROM comment p
+					WHERE p.id = @parentID
+					AND p.article_id = (SELECT id FROM art)
+					AND p.deleted_at IS NULL
+				) END
 			)
+			RETURNING id, parent_id, depth, body, author_id, created_at, updated_at, deleted_at, FALSE AS edited
+		)
+		SELECT ` + commentJSON + `
+		FROM c`
+
+	// named parameters
+	args := pgx.NamedArgs{
+		"slug":        slug,
+		"body":        body,
+		"authorID":    authorID,
+		"userID":      authorID,
+		"parentID":    parentID,
+		"deletedBody": domain.DeletedCommentBody,
+	}
+
+	rows, err := r.conn.Query(ctx, query, args)
+	if err != nil {
+		return nil, fmt.Errorf("could not insert comment: %w", domainError(err))
+	}
+
+	comment, errA := pgx.CollectExactlyOneRow(rows, pginit.JSONRowToAddrOfStruct[domain.Comment])
+	if errA != nil {
+		return nil, fmt.Errorf("could not insert comment: %w", domainError(errA))
+	}
+
+	return comment, nil
+}
+
+// GetCommentDepth returns the depth of a comment of the article that is not deleted.
+func (r *Repository) GetCommentDepth(ctx context.Context, slug string, commentID int) (int, error) {
+	query := `
+		SELECT c.depth
+		FROM comment c
+		JOIN article a ON c.article_id = a.id AND a.slug = @slug AND a.deleted_at IS NULL
+		WHERE c.id = @commentID
+		AND c.deleted_at IS NULL
 	`
 
+	var depth int
+	if err := r.conn.QueryRow(ctx, query, pgx.NamedArgs{"slug": slug, "commentID": commentID}).Scan(&depth); err != nil {
+		return 0, fmt.Errorf("could not get comment depth: %w", domainError(err))
+	}
+
+	return depth, nil
+}
+
+func (r *Repository) GetCommentAuthorship(
+	ctx context.Context,
+	slug string, commentID int,
+) (*domain.CommentAuthorship, error) {
+	// query with named args
+	query := `
+		SELECT c.author_id AS comment_author_id, a.author_id AS article_author_id, c.created_at
+		FROM comment c
+		JOIN article a ON c.article_id = a.id AND a.slug = @slug AND a.deleted_at IS NULL
+		WHERE c.id = @commentID
+		AND c.deleted_at IS NULL
+	`
+
+	// named parameters
+	args := pgx.NamedArgs{
+		"slug":      slug,
+		"commentID": commentID,
+	}
+
+	rows, errR := r.conn.Query(ctx, query, args)
+	if errR != nil {
+		return nil, fmt.Errorf("could not get comment authorship: %w", domainError(errR))
+	}
+
+	authorship, errA := pgx.CollectExactlyOneRow(
+		rows,
+		pgx.RowToAddrOfStructByName[domain.CommentAuthorship],
+	)
+	if errA != nil {
+		return nil, fmt.Errorf("could not collect row: %w", domainError(errA))
+	}
+
+	return authorship, nil
+}
+
+// UpdateComment updates the body of the comment, keeping the former one as its next revision.
+func (r *Repository) UpdateComment(
+	ctx context.Context,
+	slug string, commentID int,
+	body string,
+) (*domain.Comment, error) {
+	// query with named args, the revision is dated by the last update of the former body
+	query := `
+		WITH former AS (
+			SELECT c.id, c.body, c.updated_at
+			FROM comment c
+			JOIN article a ON c.article_id = a.id AND a.slug = @slug AND a.deleted_at IS NULL
+			WHERE c.id = @commentID
+			AND c.deleted_at IS NULL
+			FOR UPDATE OF c
+		), revision AS (
+			INSERT INTO comment_revision (comment_id, number, body, created_at)
+			SELECT
+				f.id,
+				COALESCE((SELECT MAX(number) FROM comment_revision WHERE comment_id = f.id), 0) + 1,
+				f.body,
+				f.updated_at
+			FROM former f
+		), c AS (
+			UPDATE comment u
+			SET body = @body, updated_at = NOW()
+			FROM former f
+			WHERE u.id = f.id
+			RETURNING
+				u.id, u.parent_id, u.depth, u.body, u.author_id, u.created_at, u.updated_at, u.deleted_at,
+				TRUE AS edited
+		)
+		SELECT ` + commentJSON + `
+		FROM c`
+
 	// named parameters
 	args := pgx.NamedArgs{
-		"slug":     slug,
-		"body":     body,
-		"authorID": authorID,
+		"slug":        slug,
+		"commentID":   commentID,
+		"body":        body,
+		"deletedBody": domain.DeletedCommentBody,
 	}
 
-	rows, err := r.pool.Query(ctx, query, args)
+	rows, err := r.conn.Query(ctx, query, args)
 	if err != nil {
-		return nil, fmt.Errorf("could not insert comment: %w", err)
+		return nil, fmt.Errorf("could not update comment: %w", domainError(err))
 	}
 
 	comment, errA := pgx.CollectExactlyOneRow(rows, pginit.JSONRowToAddrOfStruct[domain.Comment])
 	if errA != nil {
-		return nil, fmt.Errorf("could not insert comment: %w", errA)
+		return nil, fmt.Errorf("could not update comment: %w", domainError(errA))
 	}
 
 	return comment, nil
 }
 
+// DeleteComment deletes the comment, or replaces it by a placeholder when it has replies.
+// The placeholders left without replies are deleted along.
 func (r *Repository) DeleteComment(
 	ctx context.Context,
 	slug string, commentID int,
 ) error {
 	// query with named args
+	placeholder := `
+		UPDATE comment c
+		SET body = '', deleted_at = NOW(), updated_at = NOW()
+		WHERE c.id = @commentID
+		AND c.article_id = (SELECT id FROM article WHERE slug = @slug AND deleted_at IS NULL)
+		AND EXISTS(SELECT 1 FROM comment r WHERE r.parent_id = c.id)
+	`
+
+	revisions := `DELETE FROM comment_revision WHERE comment_id = @commentID`
+
 	query := `
 		DELETE FROM comment c
 		WHERE c.id = @commentID
-		AND c.article_id = (SELECT id FROM article WHERE slug = @slug)
+		AND c.article_id = (SELECT id FROM article WHERE slug = @slug AND deleted_at IS NULL)
+		AND NOT EXISTS(SELECT 1 FROM comment r WHERE r.parent_id = c.id)
+		RETURNING c.parent_id
+	`
+
+	orphan := `
+		DELETE FROM comment c
+		WHERE c.id = @commentID
+		AND c.deleted_at IS NOT NULL
+		AND NOT EXISTS(SELECT 1 FROM comment r WHERE r.parent_id = c.id)
+		RETURNING c.parent_id
 	`
 
 	// named parameters
 	args := pgx.NamedArgs{
-		"slug": slug,
-		"id":   commentID,
+		"slug":      slug,
+		"commentID": commentID,
 	}
 
-	_, err := r.pool.Exec(ctx, query, args)
-	if err != nil {
-		return fmt.Errorf("could not delete comment: %w", err)
-	}
+	return r.WithTx(ctx, func(repo *Repository) error {
+		cmdTag, errP := repo.conn.Exec(ctx, placeholder, args)
+		if errP != nil {
+			return fmt.Errorf("could not delete comment: %w", domainError(errP))
+		}
+
+		// the placeholder keeps none of the former bodies either
+		if cmdTag.RowsAffected() > 0 {
+			if _, err := repo.conn.Exec(ctx, revisions, args); err != nil {
+				return fmt.Errorf("could not delete comment revisions: %w", domainError(err))
+			}
+
+			return nil
+		}
+
+		var parentID *int
+
+		errD := repo.conn.QueryRow(ctx, query, args).Scan(&parentID)
+		if errD != nil && !errors.Is(errD, pgx.ErrNoRows) {
+			return fmt.Errorf("could not delete comment: %w", domainError(errD))
+		}
+
+		// walk up the placeholders the comment was the last reply of
+		for parentID != nil {
+			errO := repo.conn.QueryRow(ctx, orphan, pgx.NamedArgs{"commentID": *parentID}).Scan(&parentID)
+			if errors.Is(errO, pgx.ErrNoRows) {
+				return nil
+			}
+
+			if errO != nil {
+				return fmt.Errorf("could not delete comment placeholder: %w", domainError(errO))
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -1,9 +1,12 @@
 package db
 
 import (
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
 	"realworld/internal/domain"
 )
@@ -35,10 +38,14 @@ func TestRepository_AddComment(t *testing.T) {
 	art, errA := testrep.CreateArticle(
 		t.Context(),
 		usr.ID,
+		"how-to-train-your-dragon",
 		"How to train your dragon",
 		"Ever wonder how?",
 		"It takes a Jacobian",
 		[]string{"dragons", "training"},
+		domain.ArticleStatusPublished,
+		nil,
+		domain.ArticleMetadata{},
 	)
 	if errA != nil {
 		t.Errorf("could not create an article: %v", errA)
@@ -82,7 +89,7 @@ func TestRepository_AddComment(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testrep.AddComment(t.Context(), usr.ID, tt.args.slug, tt.args.body)
+			got, err := testrep.AddComment(t.Context(), usr.ID, tt.args.slug, tt.args.body, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Repository.AddComment() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -127,10 +134,14 @@ func TestRepository_GetComments(t *testing.T) {
 	art, errA := testrep.CreateArticle(
 		t.Context(),
 		usr.ID,
+		"how-to-train-your-dragon",
 		"How to train your dragon",
 		"Ever wonder how?",
 		"It takes a Jacobian",
 		[]string{"dragons", "training"},
+		domain.ArticleStatusPublished,
+		nil,
+		domain.ArticleMetadata{},
 	)
 	if errA != nil {
 		t.Errorf("could not create an article: %v", errA)
@@ -159,14 +170,14 @@ func TestRepository_GetComments(t *testing.T) {
 			t.Parallel()
 
 			// create a comment
-			_, errC := testrep.AddComment(t.Context(), usr.ID, art.Slug, "I like this article")
+			_, errC := testrep.AddComment(t.Context(), usr.ID, art.Slug, "I like this article", nil)
 			if errC != nil {
 				t.Errorf("could not create a comment: %v", errC)
 
 				return
 			}
 
-			got, err := testrep.GetComments(t.Context(), usr.ID, art.Slug)
+			got, err := testrep.GetComments(t.Context(), usr.ID, art.Slug, "", nil, nil, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Repository.GetComments() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -181,3 +192,468 @@ func TestRepository_GetComments(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_GetComments_Cursor(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "get_comments_cursor")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errUsr := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"jokopager",
+		"jokopager@gmail.com",
+		"",
+	)
+	if errUsr != nil {
+		t.Fatalf("could not register user: %v", errUsr)
+	}
+
+	art, errA := testrep.CreateArticle(
+		t.Context(),
+		usr.ID,
+		"how-to-page-your-dragon",
+		"How to page your dragon",
+		"Ever wonder how?",
+		"It takes a Jacobian",
+		[]string{"dragons"},
+		domain.ArticleStatusPublished,
+		nil,
+		domain.ArticleMetadata{},
+	)
+	if errA != nil {
+		t.Fatalf("could not create an article: %v", errA)
+	}
+
+	bodies := []string{"first", "second", "third"}
+	for _, body := range bodies {
+		if _, err := testrep.AddComment(t.Context(), usr.ID, art.Slug, body, nil); err != nil {
+			t.Fatalf("could not create a comment: %v", err)
+		}
+	}
+
+	limit := 2
+
+	first, errF := testrep.GetComments(t.Context(), usr.ID, art.Slug, "", &limit, nil, nil)
+	if errF != nil {
+		t.Fatalf("Repository.GetComments() error = %v", errF)
+	}
+
+	if len(first) != limit || first[0].Body != "first" || first[1].Body != "second" {
+		t.Fatalf("Repository.GetComments() first page = %v", first)
+	}
+
+	cursor := first[1].Cursor()
+
+	// the offset is ignored with a cursor
+	offset := 1
+
+	next, errN := testrep.GetComments(t.Context(), usr.ID, art.Slug, "", &limit, &offset, &cursor)
+	if errN != nil {
+		t.Fatalf("Repository.GetComments() error = %v", errN)
+	}
+
+	if len(next) != 1 || next[0].Body != "third" {
+		t.Errorf("Repository.GetComments() next page = %v", next)
+	}
+}
+
+func TestRepository_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "delete_comment")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	author, errAu := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"jokoauthor",
+		"jokoauthor@gmail.com",
+		"",
+	)
+	if errAu != nil {
+		t.Fatalf("could not register user: %v", errAu)
+	}
+
+	commenter, errC := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"jokocommenter",
+		"jokocommenter@gmail.com",
+		"",
+	)
+	if errC != nil {
+		t.Fatalf("could not register user: %v", errC)
+	}
+
+	art, errA := testrep.CreateArticle(
+		t.Context(),
+		author.ID,
+		"how-to-delete-your-dragon",
+		"How to delete your dragon",
+		"Ever wonder how?",
+		"It takes a Jacobian",
+		[]string{"dragons"},
+		domain.ArticleStatusPublished,
+		nil,
+		domain.ArticleMetadata{},
+	)
+	if errA != nil {
+		t.Fatalf("could not create an article: %v", errA)
+	}
+
+	cmt, errCmt := testrep.AddComment(t.Context(), commenter.ID, art.Slug, "I like this article", nil)
+	if errCmt != nil {
+		t.Fatalf("could not add a comment: %v", errCmt)
+	}
+
+	authorship, errG := testrep.GetCommentAuthorship(t.Context(), art.Slug, cmt.ID)
+	if errG != nil {
+		t.Fatalf("Repository.GetCommentAuthorship() error = %v", errG)
+	}
+
+	if authorship.CommentAuthorID != commenter.ID || authorship.ArticleAuthorID != author.ID {
+		t.Errorf("Repository.GetCommentAuthorship() = %v, want %v and %v", authorship, commenter.ID, author.ID)
+	}
+
+	if err := testrep.DeleteComment(t.Context(), art.Slug, cmt.ID); err != nil {
+		t.Fatalf("Repository.DeleteComment() error = %v", err)
+	}
+
+	comments, errGC := testrep.GetComments(t.Context(), author.ID, art.Slug, "", nil, nil, nil)
+	if errGC != nil {
+		t.Fatalf("Repository.GetComments() error = %v", errGC)
+	}
+
+	if len(comments) != 0 {
+		t.Errorf("Repository.DeleteComment() left %v", comments)
+	}
+
+	if _, err := testrep.GetCommentAuthorship(t.Context(), art.Slug, cmt.ID); err == nil {
+		t.Errorf("Repository.GetCommentAuthorship() of a deleted comment should fail")
+	}
+}
+
+func TestRepository_CommentThreads(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "comment_threads")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "threader", "threader@gmail.com", "")
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	for _, slug := range []string{"threads", "elsewhere"} {
+		if _, err := testrep.CreateArticle(
+			t.Context(), usr.ID, slug, slug, "d", "b", nil,
+			domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+		); err != nil {
+			t.Fatalf("could not create article: %v", err)
+		}
+	}
+
+	add := func(body string, parentID *int) *domain.Comment {
+		t.Helper()
+
+		cmt, err := testrep.AddComment(t.Context(), usr.ID, "threads", body, parentID)
+		if err != nil {
+			t.Fatalf("Repository.AddComment(%q) error = %v", body, err)
+		}
+
+		return cmt
+	}
+
+	first := add("first", nil)
+	second := add("second", nil)
+	reply := add("reply", &first.ID)
+	add("reply to reply", &reply.ID)
+	add("later reply", &first.ID)
+	add("reply to second", &second.ID)
+
+	if reply.Depth != 1 || reply.ParentID == nil || *reply.ParentID != first.ID {
+		t.Errorf("Repository.AddComment() reply = %+v, want a reply to %d", reply, first.ID)
+	}
+
+	depth, errD := testrep.GetCommentDepth(t.Context(), "threads", reply.ID)
+	if errD != nil || depth != 1 {
+		t.Errorf("Repository.GetCommentDepth() = %v, %v, want 1", depth, errD)
+	}
+
+	// a reply stays in the article of its parent
+	_, errE := testrep.AddComment(t.Context(), usr.ID, "elsewhere", "lost", &first.ID)
+	if !errors.Is(errE, domain.ErrNotFound) {
+		t.Errorf("Repository.AddComment() to another article error = %v, want %v", errE, domain.ErrNotFound)
+	}
+
+	bodies := func(limit *int, after *domain.Cursor) ([]string, []int) {
+		t.Helper()
+
+		comments, err := testrep.GetComments(t.Context(), usr.ID, "threads", "", limit, nil, after)
+		if err != nil {
+			t.Fatalf("Repository.GetComments() error = %v", err)
+		}
+
+		var (
+			gotBodies []string
+			gotDepths []int
+		)
+
+		for _, c := range comments {
+			gotBodies = append(gotBodies, c.Body)
+			gotDepths = append(gotDepths, c.Depth)
+		}
+
+		return gotBodies, gotDepths
+	}
+
+	gotBodies, gotDepths := bodies(nil, nil)
+
+	wantBodies := []string{"first", "reply", "reply to reply", "later reply", "second", "reply to second"}
+	if !slices.Equal(gotBodies, wantBodies) || !slices.Equal(gotDepths, []int{0, 1, 2, 1, 0, 1}) {
+		t.Errorf("Repository.GetComments() = %v at %v, want %v", gotBodies, gotDepths, wantBodies)
+	}
+
+	// the limit and the cursor count the threads
+	limit := 1
+	cursor := first.Cursor()
+
+	if gotBodies, _ = bodies(&limit, nil); len(gotBodies) != 4 {
+		t.Errorf("Repository.GetComments() first thread = %v", gotBodies)
+	}
+
+	if gotBodies, _ = bodies(&limit, &cursor); !slices.Equal(gotBodies, []string{"second", "reply to second"}) {
+		t.Errorf("Repository.GetComments() second thread = %v", gotBodies)
+	}
+
+	// a deleted comment with replies is left as a placeholder
+	if err := testrep.DeleteComment(t.Context(), "threads", reply.ID); err != nil {
+		t.Fatalf("Repository.DeleteComment() error = %v", err)
+	}
+
+	comments, errC := testrep.GetComments(t.Context(), usr.ID, "threads", "", nil, nil, nil)
+	if errC != nil || len(comments) != 6 {
+		t.Fatalf("Repository.GetComments() = %v, %v, want 6 comments", comments, errC)
+	}
+
+	placeholder := comments[1]
+	if !placeholder.Deleted || placeholder.Body != domain.DeletedCommentBody || placeholder.Author.Username != "" {
+		t.Errorf("Repository.GetComments() placeholder = %+v", placeholder)
+	}
+
+	if _, err := testrep.GetCommentDepth(t.Context(), "threads", reply.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.GetCommentDepth() of a placeholder error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	// deleting its last reply deletes the placeholder along
+	if err := testrep.DeleteComment(t.Context(), "threads", comments[2].ID); err != nil {
+		t.Fatalf("Repository.DeleteComment() error = %v", err)
+	}
+
+	wantBodies = []string{"first", "later reply", "second", "reply to second"}
+	if gotBodies, _ = bodies(nil, nil); !slices.Equal(gotBodies, wantBodies) {
+		t.Errorf("Repository.GetComments() after deleting the last reply = %v", gotBodies)
+	}
+}
+
+func TestRepository_UpdateComment(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "update_comment")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "editor", "editor@gmail.com", "")
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), usr.ID, "edited", "Edited", "d", "b", nil,
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create article: %v", err)
+	}
+
+	cmt, errC := testrep.AddComment(t.Context(), usr.ID, "edited", "typo", nil)
+	if errC != nil {
+		t.Fatalf("could not add comment: %v", errC)
+	}
+
+	if cmt.Edited {
+		t.Errorf("Repository.AddComment() edited = true, want false")
+	}
+
+	for _, body := range []string{"fixed", "fixed again"} {
+		updated, err := testrep.UpdateComment(t.Context(), "edited", cmt.ID, body)
+		if err != nil {
+			t.Fatalf("Repository.UpdateComment() error = %v", err)
+		}
+
+		if updated.Body != body || !updated.Edited || !updated.UpdatedAt.After(cmt.CreatedAt) {
+			t.Errorf("Repository.UpdateComment() = %+v, want an edited %q", updated, body)
+		}
+
+		if updated.Author.Username != usr.Username {
+			t.Errorf("Repository.UpdateComment() author = %v, want %v", updated.Author.Username, usr.Username)
+		}
+	}
+
+	comments, errG := testrep.GetComments(t.Context(), usr.ID, "edited", "", nil, nil, nil)
+	if errG != nil || len(comments) != 1 || !comments[0].Edited {
+		t.Errorf("Repository.GetComments() = %v, %v, want the edited comment", comments, errG)
+	}
+
+	rows, errR := testrep.conn.Query(
+		t.Context(),
+		"SELECT body FROM comment_revision WHERE comment_id = $1 ORDER BY number",
+		cmt.ID,
+	)
+	if errR != nil {
+		t.Fatalf("could not query revisions: %v", errR)
+	}
+
+	revisions, errCR := pgx.CollectRows(rows, pgx.RowTo[string])
+	if errCR != nil || !slices.Equal(revisions, []string{"typo", "fixed"}) {
+		t.Errorf("comment revisions = %v, %v, want [typo fixed]", revisions, errCR)
+	}
+
+	if _, err := testrep.UpdateComment(t.Context(), "edited", cmt.ID+1, "none"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.UpdateComment() of a missing comment error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
+
+func TestRepository_GetComments_Sort(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "get_comments_sort")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "sorter", "sorter@gmail.com", "")
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), usr.ID, "sorted", "Sorted", "d", "b", nil,
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create article: %v", err)
+	}
+
+	add := func(body string, parentID *int) *domain.Comment {
+		t.Helper()
+
+		cmt, err := testrep.AddComment(t.Context(), usr.ID, "sorted", body, parentID)
+		if err != nil {
+			t.Fatalf("Repository.AddComment(%q) error = %v", body, err)
+		}
+
+		return cmt
+	}
+
+	add("quiet", nil)
+	popular := add("popular", nil)
+	discussed := add("discussed", nil)
+	add("popular reply", &popular.ID)
+	add("popular reply again", &popular.ID)
+	add("discussed reply", &discussed.ID)
+
+	limit := 1
+	offset := 1
+
+	tests := []struct {
+		name   string
+		sort   domain.CommentSort
+		limit  *int
+		offset *int
+		want   []string
+	}{
+		{
+			name: "oldest",
+			sort: domain.CommentSortOldest,
+			want: []string{"quiet", "popular", "popular reply", "popular reply again", "discussed", "discussed reply"},
+		},
+		{
+			name: "newest, replies in the order they were written",
+			sort: domain.CommentSortNewest,
+			want: []string{"discussed", "discussed reply", "popular", "popular reply", "popular reply again", "quiet"},
+		},
+		{
+			name: "top",
+			sort: domain.CommentSortTop,
+			want: []string{"popular", "popular reply", "popular reply again", "discussed", "discussed reply", "quiet"},
+		},
+		{
+			name:   "top, second page",
+			sort:   domain.CommentSortTop,
+			limit:  &limit,
+			offset: &offset,
+			want:   []string{"discussed", "discussed reply"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			comments, err := testrep.GetComments(t.Context(), usr.ID, "sorted", tt.sort, tt.limit, tt.offset, nil)
+			if err != nil {
+				t.Fatalf("Repository.GetComments() error = %v", err)
+			}
+
+			got := make([]string, len(comments))
+			for i, c := range comments {
+				got[i] = c.Body
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Repository.GetComments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// the newest page after the cursor of the newest comment
+	cursor := discussed.Cursor()
+
+	next, errN := testrep.GetComments(t.Context(), usr.ID, "sorted", domain.CommentSortNewest, &limit, nil, &cursor)
+	if errN != nil || len(next) != 3 || next[0].Body != "popular" {
+		t.Errorf("Repository.GetComments() next newest page = %v, %v", next, errN)
+	}
+
+	art, errA := testrep.GetArticle(t.Context(), usr.ID, "sorted")
+	if errA != nil || art.CommentsCount != 6 {
+		t.Errorf("Repository.GetArticle() comments count = %v, %v, want 6", art, errA)
+	}
+}
@@ -26,6 +26,7 @@ type Queryer interface {
 	) (commandTag pgconn.CommandTag, err error)
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
 }
 
 // enforce repository interface
@@ -33,11 +34,63 @@ var _ repository.Repository = (*Repository)(nil)
 
 type Repository struct {
 	pool *pgxpool.Pool
+
+	// conn runs the queries, the pool or the transaction of WithTx
+	conn Queryer
+
+	searchLanguage string
+	txIsoLevel     pgx.TxIsoLevel
+	txMaxRetries   int
+}
+
+var (
+	ErrNilLogger         = errors.New("logger is nil")
+	ErrInvalidTxIsoLevel = errors.New("invalid transaction isolation level")
+)
+
+type RepositoryOption func(*Repository)
+
+// WithSearchLanguage sets the text search configuration of the article search,
+// such as english or simple. An empty language keeps the default, english.
+func WithSearchLanguage(language string) RepositoryOption {
+	return func(r *Repository) {
+		if language == "" {
+			return
+		}
+
+		r.searchLanguage = language
+	}
 }
 
-var ErrNilLogger = errors.New("logger is nil")
+// WithTxIsoLevel sets the isolation level of the transactions of WithTx.
+// An empty level keeps the default, read committed.
+func WithTxIsoLevel(level pgx.TxIsoLevel) RepositoryOption {
+	return func(r *Repository) {
+		if level == "" {
+			return
+		}
 
-func NewRepository(ctx context.Context, connString string) (*Repository, error) {
+		r.txIsoLevel = level
+	}
+}
+
+// WithTxMaxRetries sets how many times WithTx reruns a transaction failing to serialize.
+func WithTxMaxRetries(retries int) RepositoryOption {
+	return func(r *Repository) {
+		r.txMaxRetries = max(retries, 0)
+	}
+}
+
+const (
+	defaultSearchLanguage = "english"
+	defaultTxMaxRetries   = 3
+)
+
+func NewRepository(
+	ctx context.Context,
+	connString string,
+	opts ...RepositoryOption,
+) (*Repository, error) {
 	pgi, err := pginit.New(
 		connString,
 		pginit.WithTracer(otelpgx.WithTracerProvider(otel.GetTracerProvider())),
@@ -53,9 +106,38 @@ func NewRepository(ctx context.Context, connString string) (*Repository, error)
 		return nil, fmt.Errorf("failed to initiate connection pool: %w", err)
 	}
 
-	return &Repository{
-		pool: pool,
-	}, nil
+	repo := &Repository{
+		pool:           pool,
+		conn:           pool,
+		searchLanguage: defaultSearchLanguage,
+		txIsoLevel:     pgx.ReadCommitted,
+		txMaxRetries:   defaultTxMaxRetries,
+	}
+
+	for _, opt := range opts {
+		opt(repo)
+	}
+
+	switch repo.txIsoLevel {
+	case pgx.Serializable, pgx.RepeatableRead, pgx.ReadCommitted, pgx.ReadUncommitted:
+	default:
+		pool.Close()
+
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTxIsoLevel, repo.txIsoLevel)
+	}
+
+	// an unknown language would only fail on the first article written
+	if _, err := pool.Exec(
+		ctx,
+		"SELECT @language::regconfig",
+		pgx.NamedArgs{"language": repo.searchLanguage},
+	); err != nil {
+		pool.Close()
+
+		return nil, fmt.Errorf("invalid search language %q: %w", repo.searchLanguage, err)
+	}
+
+	return repo, nil
 }
 
 func (r *Repository) GetShutdownFuncs() map[string]func(ctx context.Context) error {
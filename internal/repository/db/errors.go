@@ -0,0 +1,76 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"realworld/internal/domain"
+)
+
+// postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgNotNullViolation    = "23502"
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+	pgCheckViolation      = "23514"
+
+	pgSerializationFailure = "40001"
+)
+
+// domainError classifies the postgres errors as domain ones,
+// keeping the original error in the chain.
+func domainError(err error) error {
+	if isDomainError(err) {
+		return err
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %w", domain.ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		// the current and former slugs of the articles
+		if pgErr.ConstraintName == "article_slug_key" ||
+			pgErr.ConstraintName == "article_slug_history_pkey" {
+			return fmt.Errorf("%w: %w", domain.ErrSlugTaken, err)
+		}
+
+		return fmt.Errorf("%w: %w", domain.ErrConflict, err)
+	// references are looked up by subselects, such as the article of a slug,
+	// which yield null when nothing matches
+	case pgNotNullViolation, pgForeignKeyViolation:
+		return fmt.Errorf("%w: %w", domain.ErrNotFound, err)
+	case pgCheckViolation:
+		return fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	// a concurrent write won, the retries of WithTx are exhausted
+	case pgSerializationFailure:
+		return fmt.Errorf("%w: %w", domain.ErrConflict, err)
+	default:
+		return err
+	}
+}
+
+func isDomainError(err error) bool {
+	for _, domainErr := range []error{
+		domain.ErrNotFound,
+		domain.ErrForbidden,
+		domain.ErrConflict,
+		domain.ErrValidation,
+		domain.ErrUnauthorized,
+	} {
+		if errors.Is(err, domainErr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"realworld/internal/domain"
+)
+
+func TestDomainError(t *testing.T) {
+	t.Parallel()
+
+	errOther := errors.New("connection refused")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "no rows",
+			err:  fmt.Errorf("collect: %w", pgx.ErrNoRows),
+			want: domain.ErrNotFound,
+		},
+		{
+			name: "unique violation",
+			err:  &pgconn.PgError{Code: pgUniqueViolation},
+			want: domain.ErrConflict,
+		},
+		{
+			name: "slug taken",
+			err:  &pgconn.PgError{Code: pgUniqueViolation, ConstraintName: "article_slug_history_pkey"},
+			want: domain.ErrSlugTaken,
+		},
+		{
+			name: "foreign key violation",
+			err:  &pgconn.PgError{Code: pgForeignKeyViolation},
+			want: domain.ErrNotFound,
+		},
+		{
+			name: "not null violation",
+			err:  &pgconn.PgError{Code: pgNotNullViolation},
+			want: domain.ErrNotFound,
+		},
+		{
+			name: "check violation",
+			err:  &pgconn.PgError{Code: pgCheckViolation},
+			want: domain.ErrValidation,
+		},
+		{
+			name: "serialization failure",
+			err:  &pgconn.PgError{Code: pgSerializationFailure},
+			want: domain.ErrConflict,
+		},
+		{
+			name: "other error",
+			err:  errOther,
+			want: errOther,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := domainError(tt.err)
+			if !errors.Is(got, tt.want) || !errors.Is(got, tt.err) {
+				t.Errorf("domainError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// already classified errors are left as is
+	conflict := domainError(&pgconn.PgError{Code: pgUniqueViolation})
+	if got := domainError(conflict); got != conflict { //nolint:errorlint // identity check
+		t.Errorf("domainError() = %v, want %v", got, conflict)
+	}
+}
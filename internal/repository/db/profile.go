@@ -10,6 +10,25 @@ import (
 	"realworld/internal/domain"
 )
 
+// profileCountsJSON adds the counts of the profile of the user u to a JSON object,
+// only the published articles are counted.
+const profileCountsJSON = `
+	'followers_count', (SELECT COUNT(*) FROM appuser_follows WHERE followee_id = u.id),
+	'following_count', (SELECT COUNT(*) FROM appuser_follows WHERE follower_id = u.id),
+	'articles_count', (
+		SELECT COUNT(*) FROM article
+		WHERE author_id = u.id AND published_at IS NOT NULL AND deleted_at IS NULL
+	),`
+
+// profileCountsColumns selects the counts of the profile of the user u, like profileCountsJSON.
+const profileCountsColumns = `
+	(SELECT COUNT(*) FROM appuser_follows WHERE followee_id = u.id) AS followers_count,
+	(SELECT COUNT(*) FROM appuser_follows WHERE follower_id = u.id) AS following_count,
+	(
+		SELECT COUNT(*) FROM article
+		WHERE author_id = u.id AND published_at IS NOT NULL AND deleted_at IS NULL
+	) AS articles_count`
+
 // implement the interface ProfileRepository with named args
 func (r *Repository) GetProfile(
 	ctx context.Context,
@@ -27,7 +46,7 @@ func (r *Repository) GetProfile(
 				FROM appuser_follows f
 				WHERE f.followee_id = u.id
 				AND f.follower_id = @userID
-			) AS following
+			) AS following,` + profileCountsColumns + `
 		FROM appuser u
 		WHERE u.username = @username
 	`
@@ -38,14 +57,14 @@ func (r *Repository) GetProfile(
 		"username": username,
 	}
 
-	rows, errR := r.pool.Query(ctx, query, args)
+	rows, errR := r.conn.Query(ctx, query, args)
 	if errR != nil {
-		return nil, fmt.Errorf("could not get profile: %w", errR)
+		return nil, fmt.Errorf("could not get profile: %w", domainError(errR))
 	}
 
 	profile, errA := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[domain.Profile])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect row: %w", errA)
+		return nil, fmt.Errorf("could not collect row: %w", domainError(errA))
 	}
 
 	return profile, nil
@@ -64,7 +83,7 @@ func (r *Repository) FollowUser(
 				u.id,
 				u.username,
 				u.bio,
-				u.img
+				u.img,` + profileCountsColumns + `
 			FROM appuser u
 			WHERE u.username = @username
 		)
@@ -78,7 +97,11 @@ func (r *Repository) FollowUser(
 			(SELECT username FROM profile),
 			(SELECT bio FROM profile),
 			(SELECT img FROM profile),
-			true AS following
+			true AS following,
+			-- the counts were taken before the follow
+			(SELECT followers_count + 1 FROM profile) AS followers_count,
+			(SELECT following_count FROM profile),
+			(SELECT articles_count FROM profile)
 	`
 
 	// named parameters
@@ -87,14 +110,14 @@ func (r *Repository) FollowUser(
 		"username":   username,
 	}
 
-	rows, errR := r.pool.Query(ctx, query, args)
+	rows, errR := r.conn.Query(ctx, query, args)
 	if errR != nil {
-		return nil, fmt.Errorf("could not follow profile: %w", errR)
+		return nil, fmt.Errorf("could not follow profile: %w", domainError(errR))
 	}
 
 	profile, errA := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[domain.Profile])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect row: %w", errA)
+		return nil, fmt.Errorf("could not collect row: %w", domainError(errA))
 	}
 
 	return profile, nil
@@ -113,7 +136,7 @@ func (r *Repository) UnfollowUser(
 				u.id,
 				u.username,
 				u.bio,
-				u.img
+				u.img,` + profileCountsColumns + `
 			FROM appuser u
 			WHERE u.username = @username
 		)
@@ -125,7 +148,11 @@ func (r *Repository) UnfollowUser(
 			p.username,
 			p.bio,
 			p.img,
-			false as following
+			false as following,
+			-- the counts were taken before the unfollow
+			p.followers_count - 1 AS followers_count,
+			p.following_count,
+			p.articles_count
 	`
 
 	// named parameters
@@ -134,15 +161,111 @@ func (r *Repository) UnfollowUser(
 		"username":   username,
 	}
 
-	rows, errR := r.pool.Query(ctx, query, args)
+	rows, errR := r.conn.Query(ctx, query, args)
 	if errR != nil {
-		return nil, fmt.Errorf("could not unfollow profile: %w", errR)
+		return nil, fmt.Errorf("could not unfollow profile: %w", domainError(errR))
 	}
 
 	profile, errA := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[domain.Profile])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect row: %w", errA)
+		return nil, fmt.Errorf("could not collect row: %w", domainError(errA))
 	}
 
 	return profile, nil
 }
+
+// GetFollowers lists the users following the user of the username, latest follow first.
+func (r *Repository) GetFollowers(
+	ctx context.Context,
+	userID uuid.UUID,
+	username string,
+	limit *int,
+	after *domain.Cursor,
+) ([]*domain.Follow, error) {
+	return r.getFollows(ctx, userID, username, "follower_id", "followee_id", limit, after)
+}
+
+// GetFollowing lists the users the user of the username follows, latest follow first.
+func (r *Repository) GetFollowing(
+	ctx context.Context,
+	userID uuid.UUID,
+	username string,
+	limit *int,
+	after *domain.Cursor,
+) ([]*domain.Follow, error) {
+	return r.getFollows(ctx, userID, username, "followee_id", "follower_id", limit, after)
+}
+
+// getFollows lists the users in the listed column of the follows of the user of the username
+// in the owner column, as seen by the user.
+func (r *Repository) getFollows(
+	ctx context.Context,
+	userID uuid.UUID,
+	username, listed, owner string,
+	limit *int,
+	after *domain.Cursor,
+) ([]*domain.Follow, error) {
+	var ownerID uuid.UUID
+	if err := r.conn.QueryRow(
+		ctx,
+		`SELECT id FROM appuser WHERE username = @username`,
+		pgx.NamedArgs{"username": username},
+	).Scan(&ownerID); err != nil {
+		return nil, fmt.Errorf("could not get profile: %w", domainError(err))
+	}
+
+	// query with named args
+	query := `
+		SELECT
+			u.username,
+			u.bio,
+			u.img,
+			EXISTS (
+				SELECT 1
+				FROM appuser_follows v
+				WHERE v.followee_id = u.id
+				AND v.follower_id = @userID
+			) AS following,` + profileCountsColumns + `,
+			u.id AS user_id,
+			f.created_at AS followed_at
+		FROM appuser_follows f
+		JOIN appuser u ON u.id = f.` + listed + `
+		WHERE f.` + owner + ` = @ownerID`
+
+	// named parameters
+	args := pgx.NamedArgs{
+		"userID":  userID,
+		"ownerID": ownerID,
+	}
+
+	// keyset pagination, latest first
+	if after != nil {
+		cursorID, errC := uuid.Parse(after.ID)
+		if errC != nil {
+			return nil, fmt.Errorf("could not parse cursor: %w", domain.ErrInvalidCursor)
+		}
+
+		query += "\nAND (f.created_at, u.id) < (@cursorCreatedAt, @cursorID)"
+		args["cursorCreatedAt"] = after.CreatedAt
+		args["cursorID"] = cursorID
+	}
+
+	query += "\nORDER BY f.created_at DESC, u.id DESC"
+
+	if limit != nil {
+		query += " LIMIT @limit"
+		args["limit"] = limit
+	}
+
+	rows, errR := r.conn.Query(ctx, query, args)
+	if errR != nil {
+		return nil, fmt.Errorf("could not get follows: %w", domainError(errR))
+	}
+
+	follows, errA := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[domain.Follow])
+	if errA != nil {
+		return nil, fmt.Errorf("could not collect row: %w", domainError(errA))
+	}
+
+	return follows, nil
+}
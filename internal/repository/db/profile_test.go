@@ -1,7 +1,9 @@
 package db
 
 import (
+	"errors"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
@@ -49,7 +51,7 @@ func TestRepository_GetProfile(t *testing.T) {
 				"jakeprofile@lop.com",
 				"122",
 			)
-			testrep.UpdateUser(t.Context(), regUser.ID, nil, nil, nil, &tt.want.Bio, &tt.want.Image)
+			testrep.UpdateUser(t.Context(), regUser.ID, nil, nil, nil, &tt.want.Bio, &tt.want.Image, nil)
 
 			got, err := testrep.GetProfile(t.Context(), regUser.ID, tt.want.Username)
 			if (err != nil) != tt.wantErr {
@@ -93,10 +95,11 @@ func TestRepository_FollowUser(t *testing.T) {
 	)
 
 	followeeProfile := &domain.Profile{
-		Username:  followee.Username,
-		Bio:       followee.Bio,
-		Image:     followee.Image,
-		Following: true,
+		Username:       followee.Username,
+		Bio:            followee.Bio,
+		Image:          followee.Image,
+		Following:      true,
+		FollowersCount: 1,
 	}
 
 	tests := []struct {
@@ -200,3 +203,86 @@ func TestRepository_UnfollowUser(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_GetFollows(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "get_follows")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	users := map[string]*domain.User{}
+
+	for _, username := range []string{"celebrity", "fan", "superfan", "viewer"} {
+		usr, err := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), username, username+"@po.com", "122")
+		if err != nil {
+			t.Fatalf("could not register user: %v", err)
+		}
+
+		users[username] = usr
+	}
+
+	for _, follow := range [][2]string{
+		{"fan", "celebrity"},
+		{"superfan", "celebrity"},
+		{"superfan", "fan"},
+		{"viewer", "superfan"},
+	} {
+		if _, err := testrep.FollowUser(t.Context(), users[follow[0]].ID, follow[1]); err != nil {
+			t.Fatalf("could not follow: %v", err)
+		}
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), users["celebrity"].ID, "famous", "Famous", "d", "b", nil,
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create article: %v", err)
+	}
+
+	profile, errP := testrep.GetProfile(t.Context(), users["viewer"].ID, "celebrity")
+	if errP != nil || profile.FollowersCount != 2 || profile.FollowingCount != 0 || profile.ArticlesCount != 1 {
+		t.Errorf("Repository.GetProfile() = %+v, %v, want 2 followers and 1 article", profile, errP)
+	}
+
+	usernames := func(follows []*domain.Follow) []string {
+		names := make([]string, len(follows))
+		for i, f := range follows {
+			names[i] = f.Username
+		}
+
+		return names
+	}
+
+	// latest follow first, with the following flag of the viewer
+	followers, errF := testrep.GetFollowers(t.Context(), users["viewer"].ID, "celebrity", nil, nil)
+	if errF != nil || !slices.Equal(usernames(followers), []string{"superfan", "fan"}) {
+		t.Fatalf("Repository.GetFollowers() = %v, %v, want [superfan fan]", usernames(followers), errF)
+	}
+
+	if !followers[0].Following || followers[1].Following || followers[0].FollowingCount != 2 {
+		t.Errorf("Repository.GetFollowers() = %+v, %+v", followers[0], followers[1])
+	}
+
+	limit := 1
+	cursor := followers[0].Cursor()
+
+	next, errN := testrep.GetFollowers(t.Context(), users["viewer"].ID, "celebrity", &limit, &cursor)
+	if errN != nil || !slices.Equal(usernames(next), []string{"fan"}) {
+		t.Errorf("Repository.GetFollowers() next page = %v, %v, want [fan]", usernames(next), errN)
+	}
+
+	following, errFg := testrep.GetFollowing(t.Context(), uuid.Nil, "superfan", nil, nil)
+	if errFg != nil || !slices.Equal(usernames(following), []string{"fan", "celebrity"}) {
+		t.Errorf("Repository.GetFollowing() = %v, %v, want [fan celebrity]", usernames(following), errFg)
+	}
+
+	if _, err := testrep.GetFollowers(t.Context(), uuid.Nil, "nobody", nil, nil); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.GetFollowers() of a missing user error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/induzo/gocom/database/pginit/v2"
+	"github.com/jackc/pgx/v5"
+
+	"realworld/internal/domain"
+)
+
+// articleRevisionJSON is a revision with its editor as seen by the user
+const articleRevisionJSON = `
+	JSON_BUILD_OBJECT(
+		'number', r.number,
+		'title', r.title,
+		'description', r.description,
+		'body', r.body,
+		'created_at', r.created_at,
+		'editor', (
+			SELECT JSON_BUILD_OBJECT(
+				'username', u.username,
+				'bio', u.bio,
+				'img', u.img,` + profileCountsJSON + `
+				'following', EXISTS(
+					SELECT 1
+					FROM appuser_follows
+					WHERE follower_id = @userID
+					AND followee_id = u.id
+				)
+			)
+			FROM appuser u
+			WHERE u.id = r.editor_id
+		)
+	)
+`
+
+// articleRevisionFrom joins the revisions of the article, when it is visible to the user
+const articleRevisionFrom = `
+	FROM article_revision r
+	JOIN article a ON r.article_id = a.id
+	WHERE a.slug = @slug
+	AND (a.published_at IS NOT NULL OR a.author_id = @userID)
+	AND a.deleted_at IS NULL
+`
+
+// queueArticleRevision records the current content of the article as its next revision,
+// unless it is the content of the latest revision.
+// The article must be locked, or just created, so that the numbers do not collide.
+func queueArticleRevision(batch *pgx.Batch, articleID, editorID uuid.UUID) {
+	batch.Queue(`
+		INSERT INTO article_revision (article_id, number, title, description, body, editor_id)
+		SELECT
+			a.id,
+			COALESCE((SELECT MAX(number) FROM article_revision WHERE article_id = a.id), 0) + 1,
+			a.title,
+			a.description,
+			a.body,
+			@editorID
+		FROM article a
+		WHERE a.id = @articleID
+		AND NOT EXISTS(
+			SELECT 1
+			FROM article_revision r
+			WHERE r.article_id = a.id
+			AND r.number = (SELECT MAX(number) FROM article_revision WHERE article_id = a.id)
+			AND r.title = a.title
+			AND r.description = a.description
+			AND r.body = a.body
+		)`,
+		pgx.NamedArgs{
+			"articleID": articleID,
+			"editorID":  editorID,
+		},
+	)
+}
+
+// GetArticleRevisions returns the revisions of the article, latest first.
+func (r *Repository) GetArticleRevisions(
+	ctx context.Context,
+	userID uuid.UUID,
+	artSlug string,
+) ([]*domain.ArticleRevision, error) {
+	query := "SELECT" + articleRevisionJSON + articleRevisionFrom + "ORDER BY r.number DESC"
+
+	rows, errR := r.conn.Query(ctx, query, pgx.NamedArgs{"slug": artSlug, "userID": userID})
+	if errR != nil {
+		return nil, fmt.Errorf("could not get article revisions: %w", domainError(errR))
+	}
+
+	revisions, errA := pgx.CollectRows(rows, pginit.JSONRowToAddrOfStruct[domain.ArticleRevision])
+	if errA != nil {
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errA))
+	}
+
+	// an article always has a revision, none means it is not visible
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("could not get article revisions: %w", domain.ErrNotFound)
+	}
+
+	return revisions, nil
+}
+
+func (r *Repository) GetArticleRevision(
+	ctx context.Context,
+	userID uuid.UUID,
+	artSlug string,
+	number int,
+) (*domain.ArticleRevision, error) {
+	query := "SELECT" + articleRevisionJSON + articleRevisionFrom + "AND r.number = @number"
+
+	rows, errR := r.conn.Query(ctx, query, pgx.NamedArgs{"slug": artSlug, "userID": userID, "number": number})
+	if errR != nil {
+		return nil, fmt.Errorf("could not get article revision: %w", domainError(errR))
+	}
+
+	revision, errA := pgx.CollectExactlyOneRow(rows, pginit.JSONRowToAddrOfStruct[domain.ArticleRevision])
+	if errA != nil {
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errA))
+	}
+
+	return revision, nil
+}
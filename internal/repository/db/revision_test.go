@@ -0,0 +1,95 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"realworld/internal/domain"
+)
+
+func TestRepository_ArticleRevisions(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "article_revisions")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	author, errA := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "editor", "editor@gmail.com", "")
+	if errA != nil {
+		t.Fatalf("could not register author: %v", errA)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), author.ID, "revised", "Revised", "d", "first", nil,
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("could not create article: %v", err)
+	}
+
+	// updating the body to the same content makes no revision
+	for _, body := range []string{"second", "third", "third"} {
+		if _, err := testrep.UpdateArticle(
+			t.Context(), author.ID, "revised", nil, nil, nil, &body, nil, nil, nil,
+		); err != nil {
+			t.Fatalf("could not update article: %v", err)
+		}
+	}
+
+	// nor does updating the tags only
+	if _, err := testrep.UpdateArticle(
+		t.Context(), author.ID, "revised", nil, nil, nil, nil, &[]string{"history"}, nil, nil,
+	); err != nil {
+		t.Fatalf("could not update article tags: %v", err)
+	}
+
+	revisions, errR := testrep.GetArticleRevisions(t.Context(), uuid.Nil, "revised")
+	if errR != nil {
+		t.Fatalf("Repository.GetArticleRevisions() error = %v", errR)
+	}
+
+	// latest first
+	wantBodies := []string{"third", "second", "first"}
+	if len(revisions) != len(wantBodies) {
+		t.Fatalf("Repository.GetArticleRevisions() = %d revisions, want %d", len(revisions), len(wantBodies))
+	}
+
+	for i, rev := range revisions {
+		if rev.Number != len(wantBodies)-i || rev.Body != wantBodies[i] {
+			t.Errorf("Repository.GetArticleRevisions()[%d] = %d %v, want %d %v",
+				i, rev.Number, rev.Body, len(wantBodies)-i, wantBodies[i])
+		}
+
+		if rev.Editor == nil || rev.Editor.Username != "editor" {
+			t.Errorf("Repository.GetArticleRevisions()[%d] editor = %v, want editor", i, rev.Editor)
+		}
+	}
+
+	second, errG := testrep.GetArticleRevision(t.Context(), uuid.Nil, "revised", 2)
+	if errG != nil || second.Body != "second" {
+		t.Errorf("Repository.GetArticleRevision() = %v, %v, want the second body", second, errG)
+	}
+
+	if _, err := testrep.GetArticleRevision(t.Context(), uuid.Nil, "revised", 4); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.GetArticleRevision() error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	// the revisions of a draft are only visible to its author
+	if _, err := testrep.UnpublishArticle(t.Context(), author.ID, "revised"); err != nil {
+		t.Fatalf("could not unpublish article: %v", err)
+	}
+
+	if _, err := testrep.GetArticleRevisions(t.Context(), uuid.Nil, "revised"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Repository.GetArticleRevisions() of a draft error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	if _, err := testrep.GetArticleRevisions(t.Context(), author.ID, "revised"); err != nil {
+		t.Errorf("Repository.GetArticleRevisions() of a draft by its author error = %v", err)
+	}
+}
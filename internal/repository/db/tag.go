@@ -13,12 +13,45 @@ func (r *Repository) GetTags(ctx context.Context) ([]domain.Tag, error) {
 		SELECT
 			ARRAY_AGG(t.name)
 		FROM tag t
+		WHERE EXISTS(
+			SELECT 1
+			FROM article_tag
+			JOIN article a ON article_tag.article_id = a.id
+			WHERE article_tag.tag_id = t.id
+			AND a.published_at IS NOT NULL
+			AND a.deleted_at IS NULL
+		)
 	`
 
 	var tags []domain.Tag
-	if err := r.pool.QueryRow(ctx, query).Scan(&tags); err != nil {
-		return nil, fmt.Errorf("could not scan tag: %w", err)
+	if err := r.conn.QueryRow(ctx, query).Scan(&tags); err != nil {
+		return nil, fmt.Errorf("could not scan tag: %w", domainError(err))
 	}
 
 	return tags, nil
 }
+
+// DeleteUnusedTags deletes the tags no article uses any more and returns how many were deleted.
+// The tags locked by an article being written are skipped, and collected on a later run.
+func (r *Repository) DeleteUnusedTags(ctx context.Context) (int64, error) {
+	query := `
+		DELETE FROM tag
+		WHERE id IN (
+			SELECT t.id
+			FROM tag t
+			WHERE NOT EXISTS(
+				SELECT 1
+				FROM article_tag
+				WHERE tag_id = t.id
+			)
+			FOR UPDATE SKIP LOCKED
+		)
+	`
+
+	cmdTag, err := r.conn.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("could not delete unused tags: %w", domainError(err))
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
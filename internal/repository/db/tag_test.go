@@ -2,6 +2,7 @@ package db
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
@@ -51,7 +52,18 @@ func TestRepository_GetTags(t *testing.T) {
 			}
 
 			// insert articles with tags
-			if _, err := testrep.CreateArticle(t.Context(), usr.ID, "title", "description", "body", []string{"tag1", "tag2"}); err != nil {
+			if _, err := testrep.CreateArticle(
+				t.Context(),
+				usr.ID,
+				"title",
+				"title",
+				"description",
+				"body",
+				[]string{"tag1", "tag2"},
+				domain.ArticleStatusPublished,
+				nil,
+				domain.ArticleMetadata{},
+			); err != nil {
 				t.Errorf("Repository.CreateArticle() error = %v", err)
 
 				return
@@ -70,3 +82,60 @@ func TestRepository_GetTags(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_DeleteUnusedTags(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "delete_unused_tags")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "gc", "gc@po.com", "123456")
+	if errU != nil {
+		t.Fatalf("Repository.RegisterUser() error = %v", errU)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), usr.ID, "kept", "kept", "description", "body", []string{"shared", "unused"},
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("Repository.CreateArticle() error = %v", err)
+	}
+
+	if _, err := testrep.CreateArticle(
+		t.Context(), usr.ID, "other", "other", "description", "body", []string{"shared"},
+		domain.ArticleStatusPublished, nil, domain.ArticleMetadata{},
+	); err != nil {
+		t.Fatalf("Repository.CreateArticle() error = %v", err)
+	}
+
+	// the tag is no longer used once the article drops it
+	if _, err := testrep.UpdateArticle(
+		t.Context(), usr.ID, "kept", nil, nil, nil, nil, &[]string{"shared"}, nil, nil,
+	); err != nil {
+		t.Fatalf("Repository.UpdateArticle() error = %v", err)
+	}
+
+	deleted, err := testrep.DeleteUnusedTags(t.Context())
+	if err != nil {
+		t.Fatalf("Repository.DeleteUnusedTags() error = %v", err)
+	}
+
+	if deleted != 1 {
+		t.Errorf("Repository.DeleteUnusedTags() = %v, want 1", deleted)
+	}
+
+	tags, errT := testrep.GetTags(t.Context())
+	if errT != nil {
+		t.Fatalf("Repository.GetTags() error = %v", errT)
+	}
+
+	if !slices.Equal(tags, []domain.Tag{"shared"}) {
+		t.Errorf("Repository.GetTags() = %v, want [shared]", tags)
+	}
+}
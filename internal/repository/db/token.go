@@ -0,0 +1,310 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"realworld/internal/domain"
+)
+
+// implement the interface RefreshTokenRepository with named args
+func (r *Repository) CreateRefreshToken(
+	ctx context.Context,
+	refreshToken *domain.RefreshToken,
+) error {
+	query := `
+		INSERT INTO refresh_token (id, family_id, appuser_id, token_hash, expires_at)
+		VALUES (@id, @familyID, @userID, @tokenHash, @expiresAt)
+	`
+
+	args := pgx.NamedArgs{
+		"id":        refreshToken.ID,
+		"familyID":  refreshToken.FamilyID,
+		"userID":    refreshToken.UserID,
+		"tokenHash": refreshToken.TokenHash,
+		"expiresAt": refreshToken.ExpiresAt,
+	}
+
+	if _, err := r.conn.Exec(ctx, query, args); err != nil {
+		return fmt.Errorf("could not insert refresh token: %w", domainError(err))
+	}
+
+	return nil
+}
+
+func (r *Repository) GetRefreshToken(
+	ctx context.Context,
+	tokenHash string,
+) (*domain.RefreshToken, error) {
+	query := `
+		SELECT id, family_id, appuser_id, token_hash, expires_at, revoked_at, created_at
+		FROM refresh_token
+		WHERE token_hash = @tokenHash
+	`
+
+	rows, errR := r.conn.Query(ctx, query, pgx.NamedArgs{"tokenHash": tokenHash})
+	if errR != nil {
+		return nil, fmt.Errorf("could not get refresh token: %w", domainError(errR))
+	}
+
+	refreshToken, errA := pgx.CollectExactlyOneRow(
+		rows,
+		pgx.RowToAddrOfStructByName[domain.RefreshToken],
+	)
+	if errA != nil {
+		return nil, fmt.Errorf("could not collect row: %w", domainError(errA))
+	}
+
+	return refreshToken, nil
+}
+
+// RotateRefreshToken revokes and replaces the current token in a single statement,
+// so that two concurrent uses of the same token can't both succeed.
+func (r *Repository) RotateRefreshToken(
+	ctx context.Context,
+	currentID uuid.UUID,
+	next *domain.RefreshToken,
+) error {
+	query := `
+		WITH revoked AS (
+			UPDATE refresh_token
+			SET revoked_at = now()
+			WHERE id = @currentID
+			AND revoked_at IS NULL
+			RETURNING family_id, appuser_id
+		)
+		INSERT INTO refresh_token (id, family_id, appuser_id, token_hash, expires_at)
+		SELECT @id, family_id, appuser_id, @tokenHash, @expiresAt
+		FROM revoked
+	`
+
+	args := pgx.NamedArgs{
+		"currentID": currentID,
+		"id":        next.ID,
+		"tokenHash": next.TokenHash,
+		"expiresAt": next.ExpiresAt,
+	}
+
+	cmdTag, err := r.conn.Exec(ctx, query, args)
+	if err != nil {
+		return fmt.Errorf("could not rotate refresh token: %w", domainError(err))
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("could not rotate refresh token: %w", domain.ErrRefreshTokenReused)
+	}
+
+	return nil
+}
+
+func (r *Repository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	query := `
+		UPDATE refresh_token
+		SET revoked_at = now()
+		WHERE family_id = @familyID
+		AND revoked_at IS NULL
+	`
+
+	if _, err := r.conn.Exec(ctx, query, pgx.NamedArgs{"familyID": familyID}); err != nil {
+		return fmt.Errorf("could not revoke refresh token family: %w", domainError(err))
+	}
+
+	return nil
+}
+
+// implement the interface TokenRevocationRepository with named args
+func (r *Repository) RevokeToken(
+	ctx context.Context,
+	userID, tokenID uuid.UUID,
+	expiresAt time.Time,
+) error {
+	// tokens that expired anyway are purged along the way
+	query := `
+		WITH purged AS (
+			DELETE FROM revoked_token
+			WHERE expires_at < now()
+		)
+		INSERT INTO revoked_token (jti, appuser_id, expires_at)
+		VALUES (@tokenID, @userID, @expiresAt)
+		ON CONFLICT DO NOTHING
+	`
+
+	args := pgx.NamedArgs{
+		"tokenID":   tokenID,
+		"userID":    userID,
+		"expiresAt": expiresAt,
+	}
+
+	if _, err := r.conn.Exec(ctx, query, args); err != nil {
+		return fmt.Errorf("could not revoke token: %w", domainError(err))
+	}
+
+	return nil
+}
+
+// RevokeAllTokens revokes the access tokens issued before now, truncated to the second
+// as the iat claim, and all the refresh and personal access tokens of the user.
+func (r *Repository) RevokeAllTokens(ctx context.Context, userID uuid.UUID) (time.Time, error) {
+	query := `
+		WITH revoked_refresh_token AS (
+			UPDATE refresh_token
+			SET revoked_at = now()
+			WHERE appuser_id = @userID
+			AND revoked_at IS NULL
+		), revoked_personal_access_token AS (
+			UPDATE personal_access_token
+			SET revoked_at = now()
+			WHERE appuser_id = @userID
+			AND revoked_at IS NULL
+		)
+		UPDATE appuser
+		SET tokens_revoked_before = date_trunc('second', now())
+		WHERE id = @userID
+		RETURNING tokens_revoked_before
+	`
+
+	var revokedBefore time.Time
+	if err := r.conn.QueryRow(ctx, query, pgx.NamedArgs{"userID": userID}).
+		Scan(&revokedBefore); err != nil {
+		return time.Time{}, fmt.Errorf("could not revoke all tokens: %w", domainError(err))
+	}
+
+	return revokedBefore, nil
+}
+
+func (r *Repository) GetTokenRevocation(
+	ctx context.Context,
+	userID, tokenID uuid.UUID,
+) (*domain.TokenRevocation, error) {
+	query := `
+		SELECT
+			EXISTS(
+				SELECT 1
+				FROM revoked_token
+				WHERE jti = @tokenID
+			) AS revoked,
+			(
+				SELECT tokens_revoked_before
+				FROM appuser
+				WHERE id = @userID
+			) AS revoked_before
+	`
+
+	args := pgx.NamedArgs{
+		"tokenID": tokenID,
+		"userID":  userID,
+	}
+
+	rows, errR := r.conn.Query(ctx, query, args)
+	if errR != nil {
+		return nil, fmt.Errorf("could not get token revocation: %w", domainError(errR))
+	}
+
+	revocation, errA := pgx.CollectExactlyOneRow(
+		rows,
+		pgx.RowToAddrOfStructByName[domain.TokenRevocation],
+	)
+	if errA != nil {
+		return nil, fmt.Errorf("could not collect row: %w", domainError(errA))
+	}
+
+	return revocation, nil
+}
+
+// implement the interface PersonalAccessTokenRepository with named args
+func (r *Repository) CreatePersonalAccessToken(
+	ctx context.Context,
+	token *domain.PersonalAccessToken,
+) error {
+	query := `
+		INSERT INTO personal_access_token (id, appuser_id, name, scopes, token_hash, expires_at)
+		VALUES (@id, @userID, @name, @scopes, @tokenHash, @expiresAt)
+		RETURNING created_at
+	`
+
+	args := pgx.NamedArgs{
+		"id":        token.ID,
+		"userID":    token.UserID,
+		"name":      token.Name,
+		"scopes":    token.Scopes,
+		"tokenHash": token.TokenHash,
+		"expiresAt": token.ExpiresAt,
+	}
+
+	if err := r.conn.QueryRow(ctx, query, args).Scan(&token.CreatedAt); err != nil {
+		return fmt.Errorf("could not insert personal access token: %w", domainError(err))
+	}
+
+	return nil
+}
+
+func (r *Repository) GetPersonalAccessTokens(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*domain.PersonalAccessToken, error) {
+	query := `
+		SELECT id, appuser_id, name, scopes, token_hash, expires_at, revoked_at, created_at
+		FROM personal_access_token
+		WHERE appuser_id = @userID
+		AND revoked_at IS NULL
+		AND expires_at > now()
+		ORDER BY created_at DESC
+	`
+
+	rows, errR := r.conn.Query(ctx, query, pgx.NamedArgs{"userID": userID})
+	if errR != nil {
+		return nil, fmt.Errorf("could not get personal access tokens: %w", domainError(errR))
+	}
+
+	tokens, errC := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[domain.PersonalAccessToken])
+	if errC != nil {
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errC))
+	}
+
+	return tokens, nil
+}
+
+// RevokePersonalAccessToken revokes the token and adds it to the revocation list
+// in a single statement.
+func (r *Repository) RevokePersonalAccessToken(
+	ctx context.Context,
+	userID, tokenID uuid.UUID,
+) error {
+	query := `
+		WITH revoked AS (
+			UPDATE personal_access_token
+			SET revoked_at = now()
+			WHERE id = @tokenID
+			AND appuser_id = @userID
+			AND revoked_at IS NULL
+			RETURNING id, appuser_id, expires_at
+		)
+		INSERT INTO revoked_token (jti, appuser_id, expires_at)
+		SELECT id, appuser_id, expires_at
+		FROM revoked
+		ON CONFLICT DO NOTHING
+	`
+
+	args := pgx.NamedArgs{
+		"tokenID": tokenID,
+		"userID":  userID,
+	}
+
+	cmdTag, err := r.conn.Exec(ctx, query, args)
+	if err != nil {
+		return fmt.Errorf("could not revoke personal access token: %w", domainError(err))
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf(
+			"could not revoke personal access token: %w",
+			domain.ErrPersonalAccessTokenNotFound,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"realworld/internal/domain"
+)
+
+func TestRepository_RotateRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "rotate_refresh_token")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"jakerefresh",
+		"jakerefresh@po.com",
+		"",
+	)
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	newToken := func(familyID uuid.UUID) *domain.RefreshToken {
+		tokenID := uuid.Must(uuid.NewV7())
+		if familyID == uuid.Nil {
+			familyID = tokenID
+		}
+
+		return &domain.RefreshToken{
+			ID:        tokenID,
+			FamilyID:  familyID,
+			UserID:    usr.ID,
+			TokenHash: domain.HashToken(tokenID.String()),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
+
+	first := newToken(uuid.Nil)
+	if err := testrep.CreateRefreshToken(t.Context(), first); err != nil {
+		t.Fatalf("Repository.CreateRefreshToken() error = %v", err)
+	}
+
+	second := newToken(first.FamilyID)
+	if err := testrep.RotateRefreshToken(t.Context(), first.ID, second); err != nil {
+		t.Fatalf("Repository.RotateRefreshToken() error = %v", err)
+	}
+
+	// rotating the same token twice is a reuse
+	if err := testrep.RotateRefreshToken(
+		t.Context(),
+		first.ID,
+		newToken(first.FamilyID),
+	); !errors.Is(err, domain.ErrRefreshTokenReused) {
+		t.Errorf("Repository.RotateRefreshToken() error = %v, want %v", err, domain.ErrRefreshTokenReused)
+	}
+
+	got, errG := testrep.GetRefreshToken(t.Context(), second.TokenHash)
+	if errG != nil {
+		t.Fatalf("Repository.GetRefreshToken() error = %v", errG)
+	}
+
+	if got.FamilyID != first.FamilyID || got.UserID != usr.ID || got.RevokedAt != nil {
+		t.Errorf("Repository.GetRefreshToken() = %v, want family %v", got, first.FamilyID)
+	}
+
+	if err := testrep.RevokeRefreshTokenFamily(t.Context(), first.FamilyID); err != nil {
+		t.Fatalf("Repository.RevokeRefreshTokenFamily() error = %v", err)
+	}
+
+	revoked, errR := testrep.GetRefreshToken(t.Context(), second.TokenHash)
+	if errR != nil {
+		t.Fatalf("Repository.GetRefreshToken() error = %v", errR)
+	}
+
+	if revoked.RevokedAt == nil {
+		t.Errorf("Repository.RevokeRefreshTokenFamily() did not revoke %v", revoked.ID)
+	}
+}
+
+func TestRepository_GetTokenRevocation(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "get_token_revocation")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"jakerevoked",
+		"jakerevoked@po.com",
+		"",
+	)
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	tokenID := uuid.Must(uuid.NewV7())
+
+	before, errB := testrep.GetTokenRevocation(t.Context(), usr.ID, tokenID)
+	if errB != nil {
+		t.Fatalf("Repository.GetTokenRevocation() error = %v", errB)
+	}
+
+	if before.Revoked || before.RevokedBefore != nil {
+		t.Errorf("Repository.GetTokenRevocation() = %v, want not revoked", before)
+	}
+
+	if err := testrep.RevokeToken(
+		t.Context(),
+		usr.ID,
+		tokenID,
+		time.Now().Add(time.Hour),
+	); err != nil {
+		t.Fatalf("Repository.RevokeToken() error = %v", err)
+	}
+
+	revokedAt, errA := testrep.RevokeAllTokens(t.Context(), usr.ID)
+	if errA != nil {
+		t.Fatalf("Repository.RevokeAllTokens() error = %v", errA)
+	}
+
+	after, errG := testrep.GetTokenRevocation(t.Context(), usr.ID, tokenID)
+	if errG != nil {
+		t.Fatalf("Repository.GetTokenRevocation() error = %v", errG)
+	}
+
+	if !after.Revoked || after.RevokedBefore == nil || !after.RevokedBefore.Equal(revokedAt) {
+		t.Errorf("Repository.GetTokenRevocation() = %v, want revoked before %v", after, revokedAt)
+	}
+}
+
+func TestRepository_RevokePersonalAccessToken(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "revoke_personal_access_token")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"jakepat",
+		"jakepat@po.com",
+		"",
+	)
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	pat, errN := domain.NewPersonalAccessToken(
+		usr.ID,
+		"release notes",
+		[]string{domain.ScopeArticlesWrite},
+		nil,
+	)
+	if errN != nil {
+		t.Fatalf("domain.NewPersonalAccessToken() error = %v", errN)
+	}
+
+	pat.TokenHash = domain.HashToken(pat.ID.String())
+
+	if err := testrep.CreatePersonalAccessToken(t.Context(), pat); err != nil {
+		t.Fatalf("Repository.CreatePersonalAccessToken() error = %v", err)
+	}
+
+	tokens, errG := testrep.GetPersonalAccessTokens(t.Context(), usr.ID)
+	if errG != nil {
+		t.Fatalf("Repository.GetPersonalAccessTokens() error = %v", errG)
+	}
+
+	if len(tokens) != 1 || tokens[0].ID != pat.ID || len(tokens[0].Scopes) != 1 {
+		t.Errorf("Repository.GetPersonalAccessTokens() = %v, want %v", tokens, pat)
+	}
+
+	if err := testrep.RevokePersonalAccessToken(t.Context(), usr.ID, pat.ID); err != nil {
+		t.Fatalf("Repository.RevokePersonalAccessToken() error = %v", err)
+	}
+
+	if err := testrep.RevokePersonalAccessToken(
+		t.Context(),
+		usr.ID,
+		pat.ID,
+	); !errors.Is(err, domain.ErrPersonalAccessTokenNotFound) {
+		t.Errorf(
+			"Repository.RevokePersonalAccessToken() error = %v, want %v",
+			err,
+			domain.ErrPersonalAccessTokenNotFound,
+		)
+	}
+
+	revocation, errR := testrep.GetTokenRevocation(t.Context(), usr.ID, pat.ID)
+	if errR != nil {
+		t.Fatalf("Repository.GetTokenRevocation() error = %v", errR)
+	}
+
+	if !revocation.Revoked {
+		t.Errorf("Repository.RevokePersonalAccessToken() did not add %v to the revocation list", pat.ID)
+	}
+
+	remaining, errL := testrep.GetPersonalAccessTokens(t.Context(), usr.ID)
+	if errL != nil {
+		t.Fatalf("Repository.GetPersonalAccessTokens() error = %v", errL)
+	}
+
+	if len(remaining) != 0 {
+		t.Errorf("Repository.GetPersonalAccessTokens() = %v, want none", remaining)
+	}
+}
+
+func TestRepository_RevokeAllTokens(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "revoke_all_tokens")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"jakeall",
+		"jakeall@po.com",
+		"",
+	)
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	pat, errN := domain.NewPersonalAccessToken(
+		usr.ID,
+		"release notes",
+		[]string{domain.ScopeArticlesWrite},
+		nil,
+	)
+	if errN != nil {
+		t.Fatalf("domain.NewPersonalAccessToken() error = %v", errN)
+	}
+
+	pat.TokenHash = domain.HashToken(pat.ID.String())
+
+	if err := testrep.CreatePersonalAccessToken(t.Context(), pat); err != nil {
+		t.Fatalf("Repository.CreatePersonalAccessToken() error = %v", err)
+	}
+
+	if _, err := testrep.RevokeAllTokens(t.Context(), usr.ID); err != nil {
+		t.Fatalf("Repository.RevokeAllTokens() error = %v", err)
+	}
+
+	// the personal access tokens are no longer listed as active
+	tokens, errG := testrep.GetPersonalAccessTokens(t.Context(), usr.ID)
+	if errG != nil {
+		t.Fatalf("Repository.GetPersonalAccessTokens() error = %v", errG)
+	}
+
+	if len(tokens) != 0 {
+		t.Errorf("Repository.GetPersonalAccessTokens() after RevokeAllTokens() = %v, want none", tokens)
+	}
+}
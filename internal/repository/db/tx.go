@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// WithTx runs fn as a unit of work: all the queries of the repository given to fn
+// go through one transaction, committed when fn returns nil and rolled back otherwise.
+// A transaction failing to serialize is rerun up to the configured retries,
+// so fn must not have side effects outside of the repository.
+// Nested calls join the transaction of the outer one.
+func (r *Repository) WithTx(ctx context.Context, fn func(repo *Repository) error) error {
+	if _, inTx := r.conn.(pgx.Tx); inTx {
+		return fn(r)
+	}
+
+	var err error
+
+	for range r.txMaxRetries + 1 {
+		err = r.runTx(ctx, fn)
+		if !isSerializationFailure(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("could not serialize transaction after %d retries: %w", r.txMaxRetries, domainError(err))
+}
+
+func (r *Repository) runTx(ctx context.Context, fn func(repo *Repository) error) (errTx error) {
+	tx, errB := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: r.txIsoLevel})
+	if errB != nil {
+		return fmt.Errorf("could not begin transaction: %w", domainError(errB))
+	}
+
+	defer func() {
+		if errTx == nil {
+			return
+		}
+
+		// the rollback must happen even if the request was canceled
+		if err := tx.Rollback(context.WithoutCancel(ctx)); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			errTx = errors.Join(errTx, fmt.Errorf("could not rollback transaction: %w", err))
+		}
+	}()
+
+	txRepo := *r
+	txRepo.conn = tx
+
+	if err := fn(&txRepo); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("could not commit transaction: %w", domainError(err))
+	}
+
+	return nil
+}
+
+func isSerializationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == pgSerializationFailure
+}
+
+// withTxResult runs fn with WithTx and returns its result once committed.
+func withTxResult[T any](
+	ctx context.Context,
+	r *Repository,
+	fn func(repo *Repository) (T, error),
+) (T, error) {
+	var result T
+
+	err := r.WithTx(ctx, func(repo *Repository) error {
+		res, err := fn(repo)
+		if err != nil {
+			return err
+		}
+
+		result = res
+
+		return nil
+	})
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return result, nil
+}
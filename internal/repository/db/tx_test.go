@@ -0,0 +1,148 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"realworld/internal/domain"
+)
+
+var errAbort = errors.New("abort")
+
+func TestRepository_WithTx(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "with_tx")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	tests := []struct {
+		name        string
+		username    string
+		fnErr       error
+		wantErr     error
+		wantCreated bool
+	}{
+		{
+			name:        "committed",
+			username:    "committed",
+			wantCreated: true,
+		},
+		{
+			name:     "rolled back",
+			username: "rolledback",
+			fnErr:    errAbort,
+			wantErr:  errAbort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testrep.WithTx(t.Context(), func(repo *Repository) error {
+				usr, err := repo.RegisterUser(
+					t.Context(), uuid.Must(uuid.NewV7()), tt.username, tt.username+"@po.com", "",
+				)
+				if err != nil {
+					return err
+				}
+
+				// the nested call joins the transaction, it sees the uncommitted user
+				bio := "nested"
+				if _, err := repo.UpdateUser(t.Context(), usr.ID, nil, nil, nil, &bio, nil, nil); err != nil {
+					t.Errorf("nested Repository.UpdateUser() error = %v", err)
+				}
+
+				return tt.fnErr
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Repository.WithTx() error = %v, want %v", err, tt.wantErr)
+			}
+
+			_, errG := testrep.GetUser(t.Context(), tt.username)
+			if created := errG == nil; created != tt.wantCreated {
+				t.Errorf("Repository.GetUser() error = %v, want created %v", errG, tt.wantCreated)
+			}
+		})
+	}
+}
+
+func TestRepository_WithTx_Retry(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "with_tx_retry")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	serializationFailure := &pgconn.PgError{Code: pgSerializationFailure}
+
+	// the first attempts fail to serialize, the last one commits
+	attempts := 0
+
+	errTx := testrep.WithTx(t.Context(), func(_ *Repository) error {
+		attempts++
+		if attempts <= defaultTxMaxRetries {
+			return serializationFailure
+		}
+
+		return nil
+	})
+	if errTx != nil {
+		t.Errorf("Repository.WithTx() error = %v", errTx)
+	}
+
+	if attempts != defaultTxMaxRetries+1 {
+		t.Errorf("Repository.WithTx() ran %d times, want %d", attempts, defaultTxMaxRetries+1)
+	}
+
+	// the retries are exhausted
+	attempts = 0
+
+	errTx = testrep.WithTx(t.Context(), func(_ *Repository) error {
+		attempts++
+
+		return serializationFailure
+	})
+	if !errors.Is(errTx, domain.ErrConflict) {
+		t.Errorf("Repository.WithTx() error = %v, want %v", errTx, domain.ErrConflict)
+	}
+
+	if attempts != defaultTxMaxRetries+1 {
+		t.Errorf("Repository.WithTx() ran %d times, want %d", attempts, defaultTxMaxRetries+1)
+	}
+}
+
+func TestNewRepository_TxIsoLevel(t *testing.T) {
+	t.Parallel()
+
+	_, errIso := NewRepository(t.Context(), dbURL, WithTxIsoLevel("snapshot"))
+	if !errors.Is(errIso, ErrInvalidTxIsoLevel) {
+		t.Errorf("NewRepository() error = %v, want %v", errIso, ErrInvalidTxIsoLevel)
+	}
+
+	repo, err := NewRepository(t.Context(), dbURL, WithTxIsoLevel(pgx.Serializable), WithTxMaxRetries(-1))
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+
+	t.Cleanup(repo.pool.Close)
+
+	if repo.txIsoLevel != pgx.Serializable || repo.txMaxRetries != 0 {
+		t.Errorf("NewRepository() = %v, %v, want serializable, 0", repo.txIsoLevel, repo.txMaxRetries)
+	}
+}
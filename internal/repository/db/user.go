@@ -2,7 +2,6 @@ package db
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 
@@ -19,10 +18,22 @@ func (r *Repository) RegisterUser(
 	email,
 	password string,
 ) (*domain.User, error) {
-	rows, err := r.pool.Query(ctx, `
+	return withTxResult(ctx, r, func(repo *Repository) (*domain.User, error) {
+		return repo.registerUser(ctx, userID, username, email, password)
+	})
+}
+
+func (r *Repository) registerUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	username,
+	email,
+	password string,
+) (*domain.User, error) {
+	rows, err := r.conn.Query(ctx, `
         INSERT INTO appuser (id, username, email, pwd)
         VALUES (@userID, @username, @email, @password)
-        RETURNING id, email, username, pwd, bio, img, created_at, updated_at`,
+        RETURNING id, email, username, pwd, bio, img, created_at, updated_at, version`,
 		pgx.NamedArgs{
 			"userID":   userID,
 			"username": username,
@@ -31,47 +42,41 @@ func (r *Repository) RegisterUser(
 		},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("could not insert user: %w", err)
+		return nil, fmt.Errorf("could not insert user: %w", domainError(err))
 	}
 
 	user, errA := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[domain.User])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect rows: %w", errA)
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errA))
 	}
 
 	return user, nil
 }
 
-func (r *Repository) AuthUser(
-	ctx context.Context,
-	email, password string,
-) (*domain.User, string, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT id, username, pwd, email, bio, img, created_at, updated_at
+func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	rows, err := r.conn.Query(ctx, `
+		SELECT id, username, pwd, email, bio, img, created_at, updated_at, version
 		FROM appuser
-		WHERE email = @email AND pwd = @password`,
+		WHERE email = @email`,
 		pgx.NamedArgs{
-			"email":    email,
-			"password": password,
+			"email": email,
 		},
 	)
 	if err != nil {
-		return nil, "", fmt.Errorf("could not get user: %w", err)
+		return nil, fmt.Errorf("could not get user: %w", domainError(err))
 	}
 
 	usr, errA := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[domain.User])
 	if errA != nil {
-		return nil, "", fmt.Errorf("could not collect rows: %w", errA)
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errA))
 	}
 
-	token := "123"
-
-	return usr, token, nil
+	return usr, nil
 }
 
 func (r *Repository) GetUser(ctx context.Context, username string) (*domain.User, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT id, username, email, pwd, bio, img
+	rows, err := r.conn.Query(ctx, `
+		SELECT id, username, email, pwd, bio, img, version
 		FROM appuser
 		WHERE username = @username`,
 		pgx.NamedArgs{
@@ -79,20 +84,20 @@ func (r *Repository) GetUser(ctx context.Context, username string) (*domain.User
 		},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("could not get user: %w", err)
+		return nil, fmt.Errorf("could not get user: %w", domainError(err))
 	}
 
 	user, errA := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[domain.User])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect rows: %w", errA)
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errA))
 	}
 
 	return user, nil
 }
 
 func (r *Repository) GetCurrentUser(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT id, username, email, pwd, bio, img, created_at, updated_at
+	rows, err := r.conn.Query(ctx, `
+		SELECT id, username, email, pwd, bio, img, created_at, updated_at, version
 		FROM appuser
 		WHERE id = @userID`,
 		pgx.NamedArgs{
@@ -100,23 +105,35 @@ func (r *Repository) GetCurrentUser(ctx context.Context, userID uuid.UUID) (*dom
 		},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("could not get user: %w", err)
+		return nil, fmt.Errorf("could not get user: %w", domainError(err))
 	}
 
 	user, errA := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[domain.User])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect rows: %w", errA)
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errA))
 	}
 
 	return user, nil
 }
 
-var ErrNoFieldsToUpdate = errors.New("no fields to update")
+var ErrNoFieldsToUpdate = fmt.Errorf("%w: no fields to update", domain.ErrValidation)
 
 func (r *Repository) UpdateUser(
 	ctx context.Context,
 	userID uuid.UUID,
 	username, email, password, bio, image *string,
+	expectedVersions []int,
+) (*domain.User, error) {
+	return withTxResult(ctx, r, func(repo *Repository) (*domain.User, error) {
+		return repo.updateUser(ctx, userID, username, email, password, bio, image, expectedVersions)
+	})
+}
+
+func (r *Repository) updateUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	username, email, password, bio, image *string,
+	expectedVersions []int,
 ) (*domain.User, error) {
 	args := pgx.NamedArgs{
 		"id": userID,
@@ -152,20 +169,36 @@ func (r *Repository) UpdateUser(
 		return nil, fmt.Errorf("UpdateUser: %w", ErrNoFieldsToUpdate)
 	}
 
+	// lock the user until the transaction ends, its version cannot change meanwhile
+	var version int
+	if err := r.conn.QueryRow(
+		ctx,
+		`SELECT version FROM appuser WHERE id = @id FOR UPDATE`,
+		args,
+	).Scan(&version); err != nil {
+		return nil, fmt.Errorf("could not get user to update: %w", domainError(err))
+	}
+
+	if !domain.VersionMatches(version, expectedVersions) {
+		return nil, fmt.Errorf("could not update user: %w", domain.ErrVersionMismatch)
+	}
+
+	updatedFields = append(updatedFields, "updated_at = NOW()", "version = version + 1")
+
 	query := `
 	UPDATE appuser
 	SET ` + strings.Join(updatedFields, `, `) + `
 	WHERE id = @id
-	RETURNING id, username, email, pwd, bio, img, created_at, updated_at`
+	RETURNING id, username, email, pwd, bio, img, created_at, updated_at, version`
 
-	rows, err := r.pool.Query(ctx, query, args)
+	rows, err := r.conn.Query(ctx, query, args)
 	if err != nil {
-		return nil, fmt.Errorf("could not update user: %w", err)
+		return nil, fmt.Errorf("could not update user: %w", domainError(err))
 	}
 
 	user, errA := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[domain.User])
 	if errA != nil {
-		return nil, fmt.Errorf("could not collect rows: %w", errA)
+		return nil, fmt.Errorf("could not collect rows: %w", domainError(errA))
 	}
 
 	return user, nil
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"errors"
 	"realworld/internal/domain"
 	"strconv"
 	"testing"
@@ -74,6 +75,70 @@ func TestRepository_RegisterUser(t *testing.T) {
 	}
 }
 
+func TestRepository_GetUserByEmail(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "get_user_by_email")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errU := testrep.RegisterUser(
+		t.Context(),
+		uuid.Must(uuid.NewV7()),
+		"jakebyemail",
+		"jakebyemail@po.com",
+		"$argon2id$v=19$m=64,t=2,p=1$c2FsdA$a2V5",
+	)
+	if errU != nil {
+		t.Fatalf("could not register user: %v", errU)
+	}
+
+	tests := []struct {
+		name    string
+		email   string
+		want    *domain.User
+		wantErr bool
+	}{
+		{
+			name:  "existing email",
+			email: "jakebyemail@po.com",
+			want:  usr,
+		},
+		{
+			name:    "unknown email",
+			email:   "unknown@po.com",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testrep.GetUserByEmail(t.Context(), tt.email)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Repository.GetUserByEmail() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.ID != tt.want.ID || got.Username != tt.want.Username ||
+				got.Password != tt.want.Password {
+				t.Errorf("Repository.GetUserByEmail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRepository_UpdateUser(t *testing.T) {
 	t.Parallel()
 
@@ -196,6 +261,7 @@ func TestRepository_UpdateUser(t *testing.T) {
 				tt.args.password,
 				tt.args.bio,
 				tt.args.image,
+				nil,
 			)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Repository.UpdateUser() error = %v, wantErr %v", err, tt.wantErr)
@@ -212,3 +278,49 @@ func TestRepository_UpdateUser(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_UpdateUser_Version(t *testing.T) {
+	t.Parallel()
+
+	testrep := withRepo(t, "update_user_version")
+	t.Cleanup(func() {
+		for _, f := range testrep.GetShutdownFuncs() {
+			if err := f(t.Context()); err != nil {
+				t.Errorf("could not shutdown: %v", err)
+			}
+		}
+	})
+
+	usr, errUsr := testrep.RegisterUser(t.Context(), uuid.Must(uuid.NewV7()), "versioned", "versioned@gmail.com", "")
+	if errUsr != nil {
+		t.Fatalf("could not register user: %v", errUsr)
+	}
+
+	bio := "first"
+
+	updated, err := testrep.UpdateUser(t.Context(), usr.ID, nil, nil, nil, &bio, nil, []int{usr.Version})
+	if err != nil {
+		t.Fatalf("Repository.UpdateUser() error = %v", err)
+	}
+
+	if updated.Version != usr.Version+1 {
+		t.Errorf("Repository.UpdateUser() version = %v, want %v", updated.Version, usr.Version+1)
+	}
+
+	bio = "stale"
+
+	if _, err := testrep.UpdateUser(
+		t.Context(), usr.ID, nil, nil, nil, &bio, nil, []int{usr.Version},
+	); !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Errorf("Repository.UpdateUser() error = %v, want %v", err, domain.ErrVersionMismatch)
+	}
+
+	got, err := testrep.GetCurrentUser(t.Context(), usr.ID)
+	if err != nil {
+		t.Fatalf("Repository.GetCurrentUser() error = %v", err)
+	}
+
+	if got.Bio != "first" || got.Version != updated.Version {
+		t.Errorf("Repository.GetCurrentUser() = %v, want the first update", got)
+	}
+}